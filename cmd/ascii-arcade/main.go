@@ -1,15 +1,22 @@
 package main
 
 import (
-	"ascii-arcade/pkg/checkers"
-	"ascii-arcade/pkg/chess"
-	"ascii-arcade/pkg/connections"
-	"ascii-arcade/pkg/crossword"
-	"ascii-arcade/pkg/gogame"
-	"ascii-arcade/pkg/minesweeper"
-	"ascii-arcade/pkg/solitaire"
-	"ascii-arcade/pkg/tetris"
-	"ascii-arcade/pkg/wordle"
+	"ascii-arcade/pkg/puzzles"
+	"ascii-arcade/pkg/registry"
+
+	// Game packages register themselves with the registry on init
+	_ "ascii-arcade/pkg/checkers"
+	_ "ascii-arcade/pkg/chess"
+	_ "ascii-arcade/pkg/connectfour"
+	_ "ascii-arcade/pkg/connections"
+	_ "ascii-arcade/pkg/crossword"
+	_ "ascii-arcade/pkg/gogame"
+	_ "ascii-arcade/pkg/minesweeper"
+	_ "ascii-arcade/pkg/snake"
+	_ "ascii-arcade/pkg/solitaire"
+	_ "ascii-arcade/pkg/sudoku"
+	_ "ascii-arcade/pkg/tetris"
+	_ "ascii-arcade/pkg/wordle"
 
 	"flag"
 	"fmt"
@@ -20,54 +27,6 @@ import (
 	zone "github.com/lrstanley/bubblezone/v2"
 )
 
-// GamesList holds the list of games.
-type GamesList struct {
-	Header string
-	Games  []string
-}
-
-var Games = []GamesList{
-	{
-		Header: "Classics",
-		Games: []string{
-			"Tetris",
-			"Snake",
-			"Solitaire",
-			"Minesweeper",
-		},
-	},
-	{
-		Header: "New York Times",
-		Games: []string{
-			"Crossword",
-			"Mini",
-			"Wordle",
-			"Connections",
-			"Sudoku",
-		},
-	},
-	{
-		Header: "Strategy Games",
-		Games: []string{
-			"Go",
-			"Chess",
-			"Checkers",
-			"Connect Four",
-		},
-	},
-}
-
-// Saver defines a game model that can persist state.
-type Saver interface {
-	SaveToFile() error
-}
-
-// ViewModel defines a view model that can render itself.
-type ViewModel interface {
-	View() tea.View
-	Help() string
-}
-
 // model holds global app state.
 type model struct {
 	windowHeight    int
@@ -75,23 +34,34 @@ type model struct {
 	isGameSelected  bool
 	isHelpSelected  bool
 	activeModel     tea.Model
-	selectedGame    string
+	selectedGame    registry.Game
 	selectedGameIdx int
-	games           []string
+	games           []registry.Game
 	searchQuery     string
 	message         string
 	noMouse         bool
 }
 
 // Creates the initial model with connections as default.
-func initialModel(startGame string, noMouse bool) model {
+func initialModel(startGame string, launches map[string]string, noMouse bool) model {
 	m := model{}
 	m.noMouse = noMouse
 	m.games = handleSearch("")
 
+	// A game opened from one of its own flags, such as a saved game to replay, takes precedence
+	if updated, ok := m.handleLaunch(launches); ok {
+		return updated
+	}
+
 	// If a start game is specified, initialize it
 	if startGame != "" {
-		m.selectedGame = strings.ToUpper(startGame[0:1]) + strings.ToLower(startGame[1:])
+		game, ok := registry.Lookup(startGame)
+		if !ok {
+			m.message = fmt.Sprintf("Unknown game %q.", startGame)
+			return m
+		}
+
+		m.selectedGame = game
 		updated, _ := m.handleSwitchModel()
 		m = updated.(model)
 	}
@@ -134,7 +104,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case "?":
-			if m.isGameSelected {
+			if _, ok := m.activeModel.(registry.Helper); ok && m.isGameSelected {
 				m.isHelpSelected = !m.isHelpSelected
 			}
 			return m, nil
@@ -238,50 +208,49 @@ func (m model) handleHomeMenuInput(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handleSwitchModel swaps in a new game model based on selected tab.
+// handleSwitchModel swaps in a new game model for the selected registry entry.
 func (m model) handleSwitchModel() (tea.Model, tea.Cmd) {
-	switch m.selectedGame {
-	case "Tetris":
-		m.activeModel = tetris.InitTetrisModel()
-	case "Snake":
-		m.activeModel = nil
-	case "Solitaire":
-		m.activeModel = solitaire.InitSolitaireModel()
-	case "Minesweeper":
-		m.activeModel = minesweeper.InitMinesweeperModel()
-	case "Crossword":
-		m.activeModel = crossword.InitCrosswordModel()
-	case "Mini":
-		m.activeModel = crossword.InitMiniModel()
-	case "Wordle":
-		m.activeModel = wordle.InitWordleModel()
-	case "Connections":
-		m.activeModel = connections.InitConnectionsModel()
-	case "Sudoku":
-		m.activeModel = nil
-	case "Go":
-		m.activeModel = gogame.InitGoModel()
-	case "Chess":
-		m.activeModel = chess.InitChessModel()
-	case "Checkers":
-		m.activeModel = checkers.InitCheckersModel()
-	case "ConnectFour":
-		m.activeModel = nil
-	default:
+	if !m.selectedGame.Implemented() {
 		m.activeModel = nil
-	}
-
-	if m.activeModel == nil {
 		m.message = "Selected game not implemented yet."
 		return m, nil
 	}
 
+	m.activeModel = m.selectedGame.New()
 	m.isGameSelected = true
-	m.isHelpSelected = true
+
+	// Open the help page first if the game provides one
+	_, hasHelp := m.activeModel.(registry.Helper)
+	m.isHelpSelected = hasHelp
 
 	return m, m.activeModel.Init()
 }
 
+// handleLaunch opens the first registered game whose launch flag was given on the command line.
+func (m model) handleLaunch(launches map[string]string) (model, bool) {
+	for _, game := range registry.All() {
+		for _, launcher := range game.Launchers {
+			value := launches[launcher.Flag]
+			if value == "" {
+				continue
+			}
+
+			activeModel, err := launcher.Open(value)
+			if err != nil {
+				m.message = fmt.Sprintf("Could not load %s: %v", game.Name, err)
+				return m, true
+			}
+
+			m.selectedGame = game
+			m.activeModel = activeModel
+			m.isGameSelected = true
+			return m, true
+		}
+	}
+
+	return m, false
+}
+
 // handleResize updates window size on resize.
 func (m model) handleResize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 	m.windowHeight = msg.Height
@@ -289,9 +258,9 @@ func (m model) handleResize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handleSaveGame saves the current model if it implements Saver.
+// handleSaveGame saves the current model if it implements registry.Saver.
 func (m model) handleSaveGame() {
-	if saver, ok := m.activeModel.(Saver); ok {
+	if saver, ok := m.activeModel.(registry.Saver); ok {
 		if err := saver.SaveToFile(); err != nil {
 			fmt.Println("Auto-save failed:", err)
 		}
@@ -299,23 +268,17 @@ func (m model) handleSaveGame() {
 }
 
 // handleSearch filters for games that contain the search query.
-func handleSearch(query string) []string {
-	var matches []string
-
+func handleSearch(query string) []registry.Game {
 	// Return all games if query is empty
 	if query == "" {
-		for _, gamesList := range Games {
-			matches = append(matches, gamesList.Games...)
-		}
-		return matches
+		return registry.All()
 	}
 
 	// Match games whose names start with the query
-	for _, list := range Games {
-		for _, game := range list.Games {
-			if strings.HasPrefix(strings.ToLower(game), strings.ToLower(query)) {
-				matches = append(matches, game)
-			}
+	var matches []registry.Game
+	for _, game := range registry.All() {
+		if strings.HasPrefix(strings.ToLower(game.Name), strings.ToLower(query)) {
+			matches = append(matches, game)
 		}
 	}
 
@@ -324,13 +287,36 @@ func handleSearch(query string) []string {
 
 // Entry point of the application.
 func main() {
+	// The prefetch subcommand downloads puzzles without starting the interface
+	if len(os.Args) > 1 && os.Args[1] == "prefetch" {
+		os.Exit(runPrefetch(os.Args[2:]))
+	}
+
 	startGame := flag.String("game", "", "Start with a specific game")
+
+	// Games add their own flags for opening directly into a position or saved game
+	launchFlags := make(map[string]*string)
+	for _, game := range registry.All() {
+		for _, launcher := range game.Launchers {
+			launchFlags[launcher.Flag] = flag.String(launcher.Flag, "", launcher.Usage)
+		}
+	}
+
+	puzzleURL := flag.String("puzzle-url", "", "Fetch NYT puzzles from a server mirroring the API at this base URL")
+	puzzleDir := flag.String("puzzle-dir", "", "Read NYT puzzles offline from JSON files in this directory")
 	noMouse := flag.Bool("no-mouse", false, "Disable mouse support")
 	flag.Parse()
 
+	puzzles.Configure(*puzzleURL, *puzzleDir)
+
+	launches := make(map[string]string)
+	for name, value := range launchFlags {
+		launches[name] = *value
+	}
+
 	zone.NewGlobal()
 
-	p := tea.NewProgram(initialModel(*startGame, *noMouse))
+	p := tea.NewProgram(initialModel(*startGame, launches, *noMouse))
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"ascii-arcade/pkg/connections"
+	"ascii-arcade/pkg/crossword"
+	"ascii-arcade/pkg/puzzles"
+	"ascii-arcade/pkg/wordle"
+
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// prefetchGame is a NYT game whose puzzles can be downloaded ahead of time.
+type prefetchGame struct {
+	name string
+
+	// first is the date of the earliest puzzle, or empty when every date is tried
+	first  string
+	stored func(date string) (bool, error)
+	fetch  func(date string) error
+}
+
+// prefetchGames lists the games the prefetch command can download.
+var prefetchGames = []prefetchGame{
+	{name: "wordle", first: wordle.FirstDate, stored: wordle.Stored, fetch: wordle.Prefetch},
+	{name: "connections", first: connections.FirstDate, stored: connections.Stored, fetch: connections.Prefetch},
+	{
+		name:   "crossword",
+		stored: func(date string) (bool, error) { return crossword.Stored(crossword.KindDaily, date) },
+		fetch:  func(date string) error { return crossword.Prefetch(crossword.KindDaily, date) },
+	},
+	{
+		name:   "mini",
+		stored: func(date string) (bool, error) { return crossword.Stored(crossword.KindMini, date) },
+		fetch:  func(date string) error { return crossword.Prefetch(crossword.KindMini, date) },
+	},
+}
+
+// prefetchJob is one puzzle to download.
+type prefetchJob struct {
+	game prefetchGame
+	date string
+}
+
+// prefetchResult is the outcome of one job.
+type prefetchResult struct {
+	prefetchJob
+	skipped  bool
+	attempts int
+	err      error
+}
+
+// prefetcher downloads puzzles with a shared rate limit and retries failed requests.
+type prefetcher struct {
+	limiter *time.Ticker
+	retries int
+}
+
+// runPrefetch downloads every puzzle of the chosen games between two dates into the
+// local databases and returns the exit code.
+func runPrefetch(args []string) int {
+	today := time.Now().Format("2006-01-02")
+
+	fs := flag.NewFlagSet("prefetch", flag.ContinueOnError)
+	from := fs.String("from", today, "First date to download (YYYY-MM-DD)")
+	to := fs.String("to", today, "Last date to download (YYYY-MM-DD)")
+	games := fs.String("games", "all", "Comma-separated games to download: wordle, connections, crossword, mini or all")
+	workers := fs.Int("workers", 4, "Number of puzzles downloaded at once")
+	rate := fs.Float64("rate", 2, "Maximum requests per second")
+	retries := fs.Int("retries", 3, "Attempts made after a failed request before giving up on a date")
+	puzzleURL := fs.String("puzzle-url", "", "Fetch NYT puzzles from a server mirroring the API at this base URL")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	selected, err := selectPrefetchGames(*games)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	dates, err := dateRange(*from, *to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	if *workers < 1 || *rate <= 0 || *retries < 0 {
+		fmt.Fprintln(os.Stderr, "workers and rate must be positive and retries cannot be negative")
+		return 2
+	}
+
+	puzzles.Configure(*puzzleURL, "")
+
+	// Skip dates before a game's first puzzle instead of asking for them
+	var jobs []prefetchJob
+	for _, game := range selected {
+		for _, date := range dates {
+			if date >= game.first {
+				jobs = append(jobs, prefetchJob{game: game, date: date})
+			}
+		}
+	}
+
+	p := &prefetcher{
+		limiter: time.NewTicker(time.Duration(float64(time.Second) / *rate)),
+		retries: *retries,
+	}
+	defer p.limiter.Stop()
+
+	queue := make(chan prefetchJob)
+	results := make(chan prefetchResult)
+	for range min(*workers, max(len(jobs), 1)) {
+		go func() {
+			for job := range queue {
+				results <- p.run(job)
+			}
+		}()
+	}
+	go func() {
+		for _, job := range jobs {
+			queue <- job
+		}
+		close(queue)
+	}()
+
+	// Report each date as it finishes and list the failures again at the end
+	var fetched, skipped int
+	var failed []prefetchResult
+	for range jobs {
+		result := <-results
+		switch {
+		case result.err != nil:
+			failed = append(failed, result)
+			fmt.Printf("%-11s %s  failed after %d attempt(s): %v\n", result.game.name, result.date, result.attempts, result.err)
+		case result.skipped:
+			skipped++
+			fmt.Printf("%-11s %s  already stored\n", result.game.name, result.date)
+		default:
+			fetched++
+			fmt.Printf("%-11s %s  fetched\n", result.game.name, result.date)
+		}
+	}
+
+	fmt.Printf("\nFetched %d, skipped %d, failed %d.\n", fetched, skipped, len(failed))
+	if len(failed) == 0 {
+		return 0
+	}
+
+	slices.SortFunc(failed, func(a, b prefetchResult) int {
+		return strings.Compare(a.game.name+a.date, b.game.name+b.date)
+	})
+	fmt.Println("Failed:")
+	for _, result := range failed {
+		fmt.Printf("  %-11s %s  %v\n", result.game.name, result.date, result.err)
+	}
+	return 1
+}
+
+// run downloads one puzzle unless it is already stored, retrying with exponential backoff
+// while the errors look temporary.
+func (p *prefetcher) run(job prefetchJob) prefetchResult {
+	result := prefetchResult{prefetchJob: job}
+
+	stored, err := job.game.stored(job.date)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	if stored {
+		result.skipped = true
+		return result
+	}
+
+	for {
+		<-p.limiter.C
+		result.attempts++
+
+		result.err = job.game.fetch(job.date)
+		if result.err == nil || result.attempts > p.retries || !puzzles.Retryable(result.err) {
+			return result
+		}
+
+		// Wait 1s, 2s, 4s, ... with up to half as much again at random so workers spread out
+		backoff := time.Second << (result.attempts - 1)
+		time.Sleep(backoff + rand.N(backoff/2))
+	}
+}
+
+// selectPrefetchGames parses the comma-separated list given to -games.
+func selectPrefetchGames(names string) ([]prefetchGame, error) {
+	if strings.EqualFold(strings.TrimSpace(names), "all") {
+		return prefetchGames, nil
+	}
+
+	var selected []prefetchGame
+	for name := range strings.SplitSeq(names, ",") {
+		name = strings.TrimSpace(name)
+		index := slices.IndexFunc(prefetchGames, func(g prefetchGame) bool { return strings.EqualFold(g.name, name) })
+		if index < 0 {
+			return nil, fmt.Errorf("unknown game %q, expected wordle, connections, crossword, mini or all", name)
+		}
+		if !slices.ContainsFunc(selected, func(g prefetchGame) bool { return g.name == prefetchGames[index].name }) {
+			selected = append(selected, prefetchGames[index])
+		}
+	}
+	return selected, nil
+}
+
+// dateRange lists every date from the first to the last, inclusive.
+func dateRange(from, to string) ([]string, error) {
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -from date %q, expected YYYY-MM-DD", from)
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -to date %q, expected YYYY-MM-DD", to)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("-to date %s is before -from date %s", to, from)
+	}
+
+	var dates []string
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day.Format("2006-01-02"))
+	}
+	return dates, nil
+}
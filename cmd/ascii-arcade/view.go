@@ -1,6 +1,7 @@
 package main
 
 import (
+	"ascii-arcade/pkg/registry"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
@@ -8,22 +9,6 @@ import (
 	zone "github.com/lrstanley/bubblezone/v2"
 )
 
-var implementedGames = map[string]bool{
-	"Tetris":       true,
-	"Snake":        false,
-	"Solitaire":    true,
-	"Minesweeper":  true,
-	"Crossword":    true,
-	"Mini":         true,
-	"Wordle":       true,
-	"Connections":  true,
-	"Sudoku":       false,
-	"Go":           true,
-	"Chess":        true,
-	"Checkers":     true,
-	"Connect Four": false,
-}
-
 var keyBindingsView string
 
 func init() {
@@ -54,10 +39,10 @@ func (m model) View() tea.View {
 	switch {
 	case m.isHelpSelected:
 		// If a help page is selected, render the help page
-		view = m.activeModel.(ViewModel).Help()
+		view = m.activeModel.(registry.Helper).Help()
 	case m.isGameSelected:
 		// If a game is selected, render the game UI
-		view = m.activeModel.(ViewModel).View().Content
+		view = m.activeModel.View().Content
 	default:
 		// Render the home menu
 		keyBindings := lipgloss.NewStyle().MarginLeft(8).Render(keyBindingsView)
@@ -107,20 +92,20 @@ func (m model) viewDefaultList() string {
 	var b strings.Builder
 	b.Grow(2048)
 
-	for _, gamesList := range Games {
+	for _, category := range registry.Categories() {
 		// Add the header for the current list.
-		b.WriteString(ListHeader.Render(gamesList.Header))
+		b.WriteString(ListHeader.Render(category.Header))
 		b.WriteByte('\n')
 
 		// Add games from the current list and highlight the selected game.
-		for _, game := range gamesList.Games {
+		for _, game := range category.Games {
 			switch {
-			case m.games[m.selectedGameIdx] == game:
-				b.WriteString(SelectedListEntry.Render("> " + game))
-			case !implementedGames[game]:
-				b.WriteString(UnimplementedListEntry.Render(game))
+			case m.games[m.selectedGameIdx].Name == game.Name:
+				b.WriteString(SelectedListEntry.Render("> " + game.Name))
+			case !game.Implemented():
+				b.WriteString(UnimplementedListEntry.Render(game.Name))
 			default:
-				b.WriteString(ListEntry.Render(game))
+				b.WriteString(ListEntry.Render(game.Name))
 			}
 			b.WriteByte('\n')
 		}
@@ -146,11 +131,11 @@ func (m model) viewFilteredList() string {
 	for i, game := range m.games {
 		switch {
 		case m.selectedGameIdx == i:
-			b.WriteString(SelectedListEntry.Render("> " + game))
-		case !implementedGames[game]:
-			b.WriteString(UnimplementedListEntry.Render(game))
+			b.WriteString(SelectedListEntry.Render("> " + game.Name))
+		case !game.Implemented():
+			b.WriteString(UnimplementedListEntry.Render(game.Name))
 		default:
-			b.WriteString(ListEntry.Render(game))
+			b.WriteString(ListEntry.Render(game.Name))
 		}
 		b.WriteByte('\n')
 	}
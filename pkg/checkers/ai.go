@@ -0,0 +1,145 @@
+package checkers
+
+import (
+	t "ascii-arcade/pkg/checkers/types"
+)
+
+const winScore = 1_000_000
+
+// Evaluation weights, in hundredths of a pawn.
+const (
+	pawnValue       = 100
+	kingValue       = 160
+	flyingKingValue = 250
+	backRankValue   = 12
+)
+
+// aiMoveMsg carries the jumps chosen by the computer opponent.
+type aiMoveMsg struct {
+	seq  int
+	path []t.Position
+}
+
+// move is a complete turn: the squares a piece passes through and the position it leaves behind.
+type move struct {
+	path []t.Position
+	next *CheckersModel
+}
+
+// bestMove searches to the given depth with alpha-beta pruning and returns the best turn for the side to move.
+func bestMove(m *CheckersModel, depth int) []t.Position {
+	var best []t.Position
+	bestScore := -winScore * 2
+	alpha, beta := -winScore*2, winScore*2
+
+	for _, mv := range m.legalMoves() {
+		score := -mv.next.negamax(depth-1, -beta, -alpha)
+		if score > bestScore {
+			best, bestScore = mv.path, score
+		}
+		alpha = max(alpha, score)
+	}
+
+	return best
+}
+
+// negamax scores a position from the point of view of the side to move.
+func (m *CheckersModel) negamax(depth, alpha, beta int) int {
+	// Keep searching through pending captures so exchanges are not cut off halfway
+	if depth <= 0 && len(m.captureMoves) == 0 {
+		return m.evaluate()
+	}
+
+	moves := m.legalMoves()
+
+	// A side that cannot move loses, and losing later is better than losing sooner
+	if len(moves) == 0 {
+		return -winScore - depth
+	}
+
+	for _, mv := range moves {
+		score := -mv.next.negamax(depth-1, -beta, -alpha)
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	return alpha
+}
+
+// legalMoves returns every complete turn for the side to move, following jump chains to their end.
+func (m *CheckersModel) legalMoves() []move {
+	var moves []move
+
+	// Captures are forced, and a capturing piece keeps jumping while it can
+	if len(m.captureMoves) > 0 {
+		for _, capture := range m.captureMoves {
+			child := m.clone()
+			child.movePiece(capture.From, capture.To)
+
+			if child.turn != m.turn {
+				moves = append(moves, move{path: []t.Position{capture.From, capture.To}, next: child})
+				continue
+			}
+
+			for _, rest := range child.legalMoves() {
+				path := append([]t.Position{capture.From}, rest.path...)
+				moves = append(moves, move{path: path, next: rest.next})
+			}
+		}
+		return moves
+	}
+
+	for y := range m.board {
+		for x := range m.board[y] {
+			if m.board[y][x].Value == Empty || m.board[y][x].Color != m.turn {
+				continue
+			}
+
+			from := pos(x, y)
+			for _, to := range m.addValidMoves(from, m.pieceDirections(from)) {
+				child := m.clone()
+				child.movePiece(from, to)
+				moves = append(moves, move{path: []t.Position{from, to}, next: child})
+			}
+		}
+	}
+
+	return moves
+}
+
+// evaluate scores material, kings, and back rank defence from the point of view of the side to move.
+func (m *CheckersModel) evaluate() int {
+	score := 0
+
+	for y := range m.board {
+		for x := range m.board[y] {
+			p := m.board[y][x]
+			if p.Value == Empty {
+				continue
+			}
+
+			value := pawnValue
+			switch {
+			case p.Value == King && m.rules().FlyingKings:
+				value = flyingKingValue
+			case p.Value == King:
+				value = kingValue
+			}
+
+			// Pawns left on the back rank stop the opponent from crowning
+			if p.Value == Pawn && m.isPromotionRow(-p.Color, y) {
+				value += backRankValue
+			}
+
+			if p.Color == m.turn {
+				score += value
+			} else {
+				score -= value
+			}
+		}
+	}
+
+	return score
+}
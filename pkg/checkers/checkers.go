@@ -5,6 +5,7 @@ import (
 	"slices"
 
 	t "ascii-arcade/pkg/checkers/types"
+	"ascii-arcade/pkg/registry"
 
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -22,6 +23,21 @@ const (
 	Black = 1
 )
 
+const (
+	minDepth     = 1
+	maxDepth     = 10
+	defaultDepth = 6
+)
+
+// Rows of the setup screen.
+const (
+	setupVariant = iota
+	setupMode
+	setupSide
+	setupDepth
+	setupStart
+)
+
 type CheckersModel struct {
 	renderer     int
 	board        [][]t.Piece
@@ -30,64 +46,131 @@ type CheckersModel struct {
 	captureMoves []t.CaptureMove
 	turn         int8
 
+	// Pieces jumped so far this turn, removed once the capture ends
+	jumped []t.Position
+
+	// Keyboard play
+	cursor     t.Position
+	showCursor bool
+
 	whitePiecesLeft int
 	blackPiecesLeft int
 
+	// Move history in numeric square notation
+	moveHistory []string
+	pendingMove string
+	startTurn   int8
+	replay      *replay
+
+	// Snapshots of earlier positions for undo and redo
+	undoStack []*CheckersModel
+	redoStack []*CheckersModel
+
+	// Setup
+	hasSelected bool
+	setupRow    int
+	variant     int
+	vsComputer  bool
+	playerColor int8
+	depth       int
+
+	// Computer opponent
+	thinking  bool
+	searchSeq int
+
+	// Draw rules
+	positionHistory []uint64
+	quietMoves      int
+
+	message string
+
+	drawOffer int8
+	endReason string
+
 	whiteWins bool
 	blackWins bool
 	gameOver  bool
 }
 
-// InitCheckersModel creates and initializes a new checkers model.
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Checkers",
+		Category: registry.Strategy,
+		Order:    3,
+		New:      func() tea.Model { return InitCheckersModel() },
+		Launchers: []registry.Launcher{
+			{
+				Flag:  "pdn",
+				Usage: "Replay the first checkers game in a PDN file",
+				Open:  func(path string) (tea.Model, error) { return LoadPDNFile(path) },
+			},
+		},
+	})
+}
+
+// InitCheckersModel creates a checkers model on the setup screen.
 func InitCheckersModel() *CheckersModel {
+	m := newGame(Ascii, 0, false, White, defaultDepth)
+	m.hasSelected = false
+	return m
+}
+
+// newGame creates and initializes a new checkers game with the given settings.
+func newGame(renderer, variant int, vsComputer bool, playerColor int8, depth int) *CheckersModel {
+	v := Variants[variant]
+
+	// Start the cursor on the front row of White's men
+	cursor := pos(v.Size/2, v.Size-v.Rows)
+	if (cursor.X+cursor.Y)%2 == 0 {
+		cursor.X--
+	}
+
 	m := CheckersModel{
-		renderer: Ascii,
-		board:    InitCheckersBoard(),
+		renderer: renderer,
+		board:    InitCheckersBoard(v),
 		selected: pos(-1, -1),
+		cursor:   cursor,
 		turn:     White,
 
-		whitePiecesLeft: 12,
-		blackPiecesLeft: 12,
+		startTurn: White,
+
+		hasSelected: true,
+		variant:     variant,
+		vsComputer:  vsComputer,
+		playerColor: playerColor,
+		depth:       depth,
+
+		whitePiecesLeft: v.Rows * v.Size / 2,
+		blackPiecesLeft: v.Rows * v.Size / 2,
 
 		whiteWins: false,
 		blackWins: false,
 		gameOver:  false,
 	}
+	m.positionHistory = []uint64{m.hash()}
 
 	return &m
 }
 
-// InitCheckersBoard initializes and returns the standard starting position of a checkersboard.
-func InitCheckersBoard() [][]t.Piece {
-	board := make([][]t.Piece, 8)
+// InitCheckersBoard initializes and returns the starting position of a checkersboard for a variant.
+func InitCheckersBoard(v Variant) [][]t.Piece {
+	board := make([][]t.Piece, v.Size)
 
-	// Set up black pieces
-	for y := range 3 {
-		for x := range 8 {
-			// Place pawns on odd squares
-			if (x+y)%2 == 1 {
-				board[y] = append(board[y], t.Piece{Value: Pawn, Color: Black})
-			} else {
-				board[y] = append(board[y], newEmptyPiece())
-			}
-		}
-	}
+	for y := range v.Size {
+		for x := range v.Size {
+			piece := newEmptyPiece()
 
-	// Set up empty rows
-	for range 8 {
-		board[3] = append(board[3], newEmptyPiece())
-		board[4] = append(board[4], newEmptyPiece())
-	}
-
-	// Set up white pieces
-	for y := 5; y < 8; y++ {
-		for x := range 8 {
-			// Place pawns on odd squares
+			// Place pawns on odd squares in the rows nearest each player
 			if (x+y)%2 == 1 {
-				board[y] = append(board[y], t.Piece{Value: Pawn, Color: White})
-			} else {
-				board[y] = append(board[y], newEmptyPiece())
+				switch {
+				case y < v.Rows:
+					piece = t.Piece{Value: Pawn, Color: Black}
+				case y >= v.Size-v.Rows:
+					piece = t.Piece{Value: Pawn, Color: White}
+				}
 			}
+
+			board[y] = append(board[y], piece)
 		}
 	}
 
@@ -99,14 +182,25 @@ func (m *CheckersModel) Init() tea.Cmd {
 	return nil
 }
 
-// Update handles keypress and mouse events to update the Checkers game state.
+// Update handles keypress, mouse, and computer move events to update the Checkers game state.
 func (m *CheckersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case aiMoveMsg:
+		return m.handleComputerMove(msg)
+
 	// Handle keyboard input
 	case tea.KeyPressMsg:
 		switch msg.String() {
 		case "ctrl+r":
-			return InitCheckersModel(), nil
+			return m.restart()
+		case "ctrl+n":
+			next := InitCheckersModel()
+			next.renderer = m.renderer
+			next.variant = m.variant
+			next.vsComputer = m.vsComputer
+			next.playerColor = m.playerColor
+			next.depth = m.depth
+			return next, nil
 		case "1":
 			m.renderer = Block
 			return m, nil
@@ -118,6 +212,48 @@ func (m *CheckersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if !m.hasSelected {
+			return m.handleSetupKey(msg)
+		}
+
+		if m.replay != nil {
+			return m.handleReplayKey(msg)
+		}
+
+		// Answer a pending draw offer
+		if m.drawOffer != Empty && !m.gameOver {
+			switch msg.String() {
+			case "y":
+				m.handleDrawResponse(true)
+				return m, nil
+			case "n":
+				m.handleDrawResponse(false)
+				return m, nil
+			}
+		}
+
+		switch msg.String() {
+		case "u", "ctrl+z":
+			return m, m.handleUndo()
+		case "U", "ctrl+shift+z":
+			return m, m.handleRedo()
+		case "ctrl+d":
+			m.handleOfferDraw()
+			return m, nil
+		case "ctrl+x":
+			m.handleResign()
+			return m, nil
+		case "ctrl+e":
+			if err := m.saveGame(); err != nil {
+				m.message = err.Error()
+			} else {
+				m.message = "Game saved to " + gamesFile + "."
+			}
+			return m, nil
+		}
+
+		return m.handleBoardKey(msg)
+
 	// Handle mouse input
 	case tea.MouseClickMsg:
 		return m.handleMouseClick(msg)
@@ -133,11 +269,31 @@ func (m *CheckersModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd)
 		return m, nil
 	}
 
+	// Handle the setup screen
+	if !m.hasSelected {
+		for row := setupVariant; row <= setupStart; row++ {
+			if !zone.Get(fmt.Sprintf("setup_%d", row)).InBounds(msg) {
+				continue
+			}
+			if row == setupStart {
+				return m.restart()
+			}
+			m.setupRow = row
+			m.changeSetting(row, 1)
+		}
+		return m, nil
+	}
+
+	// The board is read only while replaying a game
+	if m.replay != nil {
+		return m, nil
+	}
+
 	// Handle game over UI
 	if m.gameOver {
 		switch {
 		case zone.Get("reset").InBounds(msg):
-			return InitCheckersModel(), nil
+			return m.restart()
 		case zone.Get("exit").InBounds(msg):
 			return m, func() tea.Msg { return "home" }
 		default:
@@ -145,77 +301,141 @@ func (m *CheckersModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd)
 		}
 	}
 
+	// Check if a square was clicked
+	for y := range m.board {
+		for x := range m.board[y] {
+			// Each square is labeled by its index
+			label := fmt.Sprint(y*len(m.board) + x)
+			if zone.Get(label).InBounds(msg) {
+				m.cursor = pos(x, y)
+				return m.handleSquare(pos(x, y))
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handleSquare selects a piece or moves the selected piece to the given square.
+func (m *CheckersModel) handleSquare(clicked t.Position) (tea.Model, tea.Cmd) {
+	// Ignore the board while the computer is moving
+	if m.isComputerTurn() {
+		return m, nil
+	}
+
 	// Handle capture moves
 	if len(m.captureMoves) > 0 {
 		for _, move := range m.captureMoves {
-			label := fmt.Sprint(move.To.Y*len(m.board) + move.To.X)
-			if zone.Get(label).InBounds(msg) {
+			if move.To == clicked {
 				m.handleMovePiece(move.From, move.To)
-				return m, nil
+				return m, m.startComputerTurn()
 			}
 		}
 
 		return m, nil
 	}
 
-	// Check if a piece was clicked
-	for y := range m.board {
-		for x := range m.board[y] {
-			// Each square is labeled by its index
-			label := fmt.Sprint(y*len(m.board) + x)
-			if !zone.Get(label).InBounds(msg) {
-				continue
-			}
+	piece := m.board[clicked.Y][clicked.X]
 
-			piece := m.board[y][x]
-			clicked := pos(x, y)
+	// If a piece is already selected
+	if m.selected.X != -1 && m.selected.Y != -1 {
+		// Move if clicked on a valid move position
+		if slices.Contains(m.validMoves, clicked) {
+			m.handleMovePiece(m.selected, clicked)
+			return m, m.startComputerTurn()
+		}
 
-			// If a piece is already selected
-			if m.selected.X != -1 && m.selected.Y != -1 {
-				// Move if clicked on a valid move position
-				if slices.Contains(m.validMoves, clicked) {
-					m.handleMovePiece(m.selected, clicked)
-					return m, nil
-				}
+		// Deselect if clicked the same square again
+		if m.selected == clicked {
+			m.selected = pos(-1, -1)
+			m.validMoves = nil
+			return m, nil
+		}
 
-				// Deselect if clicked the same square again
-				if m.selected.X == x && m.selected.Y == y {
-					m.selected = pos(-1, -1)
-					m.validMoves = nil
-					return m, nil
-				}
+		// Select a different piece
+		if piece.Color == m.turn {
+			m.selected = clicked
+			m.validMoves = nil
+			m.generateValidMoves(clicked)
+		}
 
-				// Select a different piece
-				if piece.Color == m.turn {
-					m.selected = clicked
-					m.validMoves = nil
-					m.generateValidMoves(clicked)
-				}
+		return m, nil
+	}
 
-				return m, nil
-			}
+	// If no piece is selected piece, select the clicked piece
+	if piece.Value != Empty && piece.Color == m.turn {
+		m.selected = clicked
+		m.validMoves = nil
+		m.generateValidMoves(clicked)
+	}
 
-			// If no piece is selected piece, select the clicked piece
-			if piece.Value != Empty && piece.Color == m.turn {
-				m.selected = clicked
-				m.validMoves = nil
-				m.generateValidMoves(clicked)
-			}
+	return m, nil
+}
 
-			return m, nil
-		}
+// handleSetupKey handles keyboard input on the setup screen.
+func (m *CheckersModel) handleSetupKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "w", "k":
+		m.setupRow = m.prevSetupRow()
+	case "down", "s", "j", "tab":
+		m.setupRow = m.nextSetupRow()
+	case "left", "a", "h":
+		m.changeSetting(m.setupRow, -1)
+	case "right", "d", "l", "space":
+		m.changeSetting(m.setupRow, 1)
+	case "enter":
+		return m.restart()
+	}
+
+	return m, nil
+}
+
+// handleComputerMove plays the jumps chosen by the computer opponent.
+func (m *CheckersModel) handleComputerMove(msg aiMoveMsg) (tea.Model, tea.Cmd) {
+	// Drop results from searches that belong to an earlier game
+	if msg.seq != m.searchSeq || !m.isComputerTurn() {
+		return m, nil
+	}
+
+	m.thinking = false
+	for i := 1; i < len(msg.path); i++ {
+		m.handleMovePiece(msg.path[i-1], msg.path[i])
 	}
 
 	return m, nil
 }
 
+// handleMovePiece executes a move or a single jump of a capture sequence.
 func (m *CheckersModel) handleMovePiece(from, to t.Position) {
-	piece := m.board[from.Y][from.X]
+	turn := m.turn
+	m.pushUndo()
+
+	// Build up the notation one jump at a time
+	switch {
+	case len(m.captureMoves) == 0:
+		m.pendingMove = m.squareName(from) + "-" + m.squareName(to)
+	case len(m.jumped) == 0:
+		m.pendingMove = m.squareName(from) + "x" + m.squareName(to)
+	default:
+		m.pendingMove += "x" + m.squareName(to)
+	}
 
-	// If a pawn moves to the end of the board, it becomes king
-	if piece.Value == Pawn && ((m.turn == White && to.Y == 0) || (m.turn == Black && to.Y == 7)) {
-		piece.Value = King
+	m.movePiece(from, to)
+	m.message = ""
+	m.drawOffer = Empty
+
+	// Record the move and check if the game has ended once the turn passes
+	if m.turn != turn {
+		m.moveHistory = append(m.moveHistory, m.pendingMove)
+		m.pendingMove = ""
+		m.updateGameState()
 	}
+}
+
+// movePiece moves a piece, removes the jumped pieces once a capture ends, and passes the turn.
+func (m *CheckersModel) movePiece(from, to t.Position) {
+	piece := m.board[from.Y][from.X]
+	capture := len(m.captureMoves) > 0
 
 	// Move the piece
 	m.board[to.Y][to.X] = piece
@@ -225,38 +445,140 @@ func (m *CheckersModel) handleMovePiece(from, to t.Position) {
 	m.selected = pos(-1, -1)
 	m.validMoves = nil
 
-	if len(m.captureMoves) > 0 {
-		// Calculate the midpoint
-		midX := (from.X + to.X) / 2
-		midY := (from.Y + to.Y) / 2
+	if capture {
+		m.jumped = append(m.jumped, m.jumpedPiece(from, to))
+
+		// Some variants crown a man as soon as it reaches the far row
+		if m.rules().PromoteDuringCapture && piece.Value == Pawn && m.isPromotionRow(piece.Color, to.Y) {
+			m.board[to.Y][to.X].Value = King
+		}
 
-		// Remove captured piece
-		captured := m.board[midY][midX]
-		m.board[midY][midX] = newEmptyPiece()
+		// The same piece keeps jumping while it can
+		m.generateCaptureMoves(to)
+		if len(m.captureMoves) > 0 {
+			return
+		}
+
+		m.removeJumped()
+	}
 
+	// If a pawn ends its move on the far row, it becomes king
+	if piece.Value == Pawn && m.isPromotionRow(piece.Color, to.Y) {
+		m.board[to.Y][to.X].Value = King
+	}
+
+	// Count turns without a capture for the draw rule
+	if capture {
+		m.quietMoves = 0
+	} else {
+		m.quietMoves++
+	}
+
+	// Swap turns and generate possible captures
+	m.turn = m.turn * -1
+	m.generateAllCaptureMoves(m.turn)
+}
+
+// removeJumped takes the pieces captured this turn off the board.
+func (m *CheckersModel) removeJumped() {
+	for _, p := range m.jumped {
 		// Update piece counts
-		switch captured.Color {
+		switch m.board[p.Y][p.X].Color {
 		case White:
 			m.whitePiecesLeft--
 		case Black:
 			m.blackPiecesLeft--
 		}
 
-		// Check if the game has ended
-		m.whiteWins = m.blackPiecesLeft == 0
-		m.blackWins = m.whitePiecesLeft == 0
-		m.gameOver = m.whiteWins || m.blackWins
+		m.board[p.Y][p.X] = newEmptyPiece()
+	}
 
-		// Check if more captures are possible
-		m.generateAllCaptureMoves(piece.Color)
-		if len(m.captureMoves) > 0 {
-			return
-		}
+	m.jumped = nil
+}
+
+// restart begins a new game with the current settings.
+func (m *CheckersModel) restart() (tea.Model, tea.Cmd) {
+	next := newGame(m.renderer, m.variant, m.vsComputer, m.playerColor, m.depth)
+	next.searchSeq = m.searchSeq + 1
+	next.showCursor = m.showCursor
+	return next, next.startComputerTurn()
+}
+
+// isComputerTurn reports whether the computer opponent is to move.
+func (m *CheckersModel) isComputerTurn() bool {
+	return m.vsComputer && !m.gameOver && m.turn != m.playerColor
+}
+
+// startComputerTurn returns a command that searches for the computer's move off the UI goroutine.
+func (m *CheckersModel) startComputerTurn() tea.Cmd {
+	if !m.isComputerTurn() {
+		return nil
 	}
 
-	// Swap turns and generate possible captures
-	m.turn = m.turn * -1
-	m.generateAllCaptureMoves(m.turn)
+	m.thinking = true
+	seq := m.searchSeq
+	position := m.clone()
+
+	// The search only needs the board, so skip copying the history at every node
+	position.moveHistory = nil
+	position.positionHistory = nil
+	position.undoStack = nil
+	position.redoStack = nil
+	depth := m.depth
+
+	return func() tea.Msg {
+		return aiMoveMsg{seq: seq, path: bestMove(position, depth)}
+	}
+}
+
+// changeSetting cycles the value of a setup row in the given direction.
+func (m *CheckersModel) changeSetting(row, delta int) {
+	switch row {
+	case setupVariant:
+		m.variant = (m.variant + delta + len(Variants)) % len(Variants)
+	case setupMode:
+		m.vsComputer = !m.vsComputer
+	case setupSide:
+		m.playerColor *= -1
+	case setupDepth:
+		m.depth = min(max(m.depth+delta, minDepth), maxDepth)
+	}
+}
+
+// nextSetupRow moves down the setup screen, skipping computer options in two player mode.
+func (m *CheckersModel) nextSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupMode {
+		return setupStart
+	}
+	return min(m.setupRow+1, setupStart)
+}
+
+// prevSetupRow moves up the setup screen, skipping computer options in two player mode.
+func (m *CheckersModel) prevSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupStart {
+		return setupMode
+	}
+	return max(m.setupRow-1, setupVariant)
+}
+
+// clone returns a deep copy of the game state.
+func (m *CheckersModel) clone() *CheckersModel {
+	c := *m
+
+	c.board = make([][]t.Piece, len(m.board))
+	for y := range m.board {
+		c.board[y] = slices.Clone(m.board[y])
+	}
+
+	c.validMoves = slices.Clone(m.validMoves)
+	c.captureMoves = slices.Clone(m.captureMoves)
+	c.jumped = slices.Clone(m.jumped)
+	c.moveHistory = slices.Clone(m.moveHistory)
+	c.positionHistory = slices.Clone(m.positionHistory)
+	c.undoStack = slices.Clone(m.undoStack)
+	c.redoStack = slices.Clone(m.redoStack)
+
+	return &c
 }
 
 // newEmptyPiece returns a new empty piece.
@@ -0,0 +1,183 @@
+package checkers
+
+import (
+	"math/rand/v2"
+)
+
+// quietMoveLimit is the number of turns without a capture, forty for each side, after which the game is drawn.
+const quietMoveLimit = 80
+
+// Random keys for Zobrist hashing, generated from a fixed seed so hashes are stable.
+var (
+	pieceKeys   [2][King + 1][10][10]uint64
+	blackToMove uint64
+)
+
+func init() {
+	r := rand.New(rand.NewPCG(0x636865, 0x636b))
+
+	for color := range pieceKeys {
+		for value := range pieceKeys[color] {
+			for y := range 10 {
+				for x := range 10 {
+					pieceKeys[color][value][y][x] = r.Uint64()
+				}
+			}
+		}
+	}
+	blackToMove = r.Uint64()
+}
+
+// hash returns the Zobrist hash of the position, used to detect repetitions.
+func (m *CheckersModel) hash() uint64 {
+	var h uint64
+
+	for y := range m.board {
+		for x := range m.board[y] {
+			p := m.board[y][x]
+			if p.Value == Empty {
+				continue
+			}
+
+			side := 0
+			if p.Color == Black {
+				side = 1
+			}
+			h ^= pieceKeys[side][p.Value][y][x]
+		}
+	}
+
+	if m.turn == Black {
+		h ^= blackToMove
+	}
+
+	return h
+}
+
+// updateGameState ends the game when a side is out of pieces or moves, or when the game is drawn.
+func (m *CheckersModel) updateGameState() {
+	m.positionHistory = append(m.positionHistory, m.hash())
+
+	switch {
+	case m.whitePiecesLeft == 0 || m.blackPiecesLeft == 0:
+		m.endGame(m.turn*-1, "by capturing every piece")
+	case !m.hasLegalMoves():
+		m.endGame(m.turn*-1, "by blocking every move")
+	case m.quietMoves >= quietMoveLimit:
+		m.endGame(Empty, "by the 40-move rule")
+	case m.isThreefoldRepetition():
+		m.endGame(Empty, "by threefold repetition")
+	}
+}
+
+// hasLegalMoves reports whether the side to move can make any move.
+func (m *CheckersModel) hasLegalMoves() bool {
+	if len(m.captureMoves) > 0 {
+		return true
+	}
+
+	for y := range m.board {
+		for x := range m.board[y] {
+			p := m.board[y][x]
+			if p.Value == Empty || p.Color != m.turn {
+				continue
+			}
+
+			if len(m.addValidMoves(pos(x, y), m.pieceDirections(pos(x, y)))) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isThreefoldRepetition reports whether the current position has occurred three times.
+func (m *CheckersModel) isThreefoldRepetition() bool {
+	if len(m.positionHistory) == 0 {
+		return false
+	}
+
+	current := m.positionHistory[len(m.positionHistory)-1]
+	count := 0
+	for _, h := range m.positionHistory {
+		if h == current {
+			count++
+		}
+	}
+	return count >= 3
+}
+
+// handleOfferDraw offers a draw on behalf of the side to move.
+func (m *CheckersModel) handleOfferDraw() {
+	if m.gameOver || m.isComputerTurn() || len(m.jumped) > 0 {
+		return
+	}
+
+	// The computer accepts when its position is no better than equal
+	if m.vsComputer {
+		if m.engineAcceptsDraw() {
+			m.endGame(Empty, "by agreement")
+		} else {
+			m.message = "The computer declines the draw."
+		}
+		return
+	}
+
+	m.drawOffer = m.turn
+	m.message = colorName(m.turn) + " offers a draw. Accept? (y / n)"
+}
+
+// handleDrawResponse accepts or declines a pending draw offer.
+func (m *CheckersModel) handleDrawResponse(accept bool) {
+	if accept {
+		m.endGame(Empty, "by agreement")
+		return
+	}
+
+	m.message = colorName(m.drawOffer*-1) + " declines the draw."
+	m.drawOffer = Empty
+}
+
+// handleResign ends the game in favor of the opponent of the resigning player.
+func (m *CheckersModel) handleResign() {
+	if m.gameOver {
+		return
+	}
+
+	// Against the computer it is always the player who resigns
+	loser := m.turn
+	if m.vsComputer {
+		loser = m.playerColor
+	}
+
+	m.endGame(loser*-1, "by resignation")
+}
+
+// endGame finishes the game with the given winner, or a draw when winner is Empty.
+func (m *CheckersModel) endGame(winner int8, reason string) {
+	m.whiteWins = winner == White
+	m.blackWins = winner == Black
+	m.endReason = reason
+	m.gameOver = true
+	m.drawOffer = Empty
+	m.thinking = false
+	m.searchSeq++
+	m.selected = pos(-1, -1)
+	m.validMoves = nil
+}
+
+// engineAcceptsDraw runs a short search and reports whether the computer is not ahead.
+func (m *CheckersModel) engineAcceptsDraw() bool {
+	position := m.clone()
+	position.moveHistory = nil
+	position.positionHistory = nil
+	position.undoStack = nil
+	position.redoStack = nil
+	score := position.negamax(2, -winScore*2, winScore*2)
+
+	// Scores are from the point of view of the side to move
+	if m.turn == m.playerColor {
+		score = -score
+	}
+	return score <= 0
+}
@@ -1,50 +1,74 @@
 package checkers
 
 import (
+	"slices"
+
 	t "ascii-arcade/pkg/checkers/types"
 )
 
+var (
+	forwardWhite = []t.Position{{X: -1, Y: -1}, {X: 1, Y: -1}}
+	forwardBlack = []t.Position{{X: -1, Y: 1}, {X: 1, Y: 1}}
+	diagonals    = []t.Position{{X: -1, Y: -1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: 1, Y: 1}}
+)
+
 // generateValidMoves determines all legal moves for the selected piece
 func (m *CheckersModel) generateValidMoves(selected t.Position) {
-	// Determine valid directions based on piece type
-	var directions []t.Position
-	switch m.board[selected.Y][selected.X].Value {
-	case Pawn:
-		dy := int(m.board[selected.Y][selected.X].Color)
-		directions = []t.Position{
-			{X: -1, Y: dy}, {X: 1, Y: dy},
-		}
-	case King:
-		directions = []t.Position{
-			{X: -1, Y: -1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: 1, Y: 1},
-		}
-	}
-
 	// Add all valid moves to the list
-	m.validMoves = m.addValidMoves(selected, directions)
+	m.validMoves = m.addValidMoves(selected, m.pieceDirections(selected))
 }
 
-// addValidMoves returns all single step non-capturing moves for a given piece
+// addValidMoves returns all non-capturing moves for a given piece
 func (m *CheckersModel) addValidMoves(from t.Position, directions []t.Position) []t.Position {
 	validMoves := make([]t.Position, 0)
+	flying := m.board[from.Y][from.X].Value == King && m.rules().FlyingKings
 
 	for _, d := range directions {
+		// Flying kings slide until they meet another piece
 		x, y := from.X+d.X, from.Y+d.Y
-
-		if inBounds(x, y) && m.board[y][x].Value == Empty {
+		for m.inBounds(x, y) && m.board[y][x].Value == Empty {
 			validMoves = append(validMoves, pos(x, y))
+			if !flying {
+				break
+			}
+			x, y = x+d.X, y+d.Y
 		}
 	}
 
 	return validMoves
 }
 
+// pieceDirections returns the diagonal directions the piece at a position may move in.
+func (m *CheckersModel) pieceDirections(from t.Position) []t.Position {
+	piece := m.board[from.Y][from.X]
+	switch {
+	case piece.Value == King:
+		return diagonals
+	case piece.Color == White:
+		return forwardWhite
+	default:
+		return forwardBlack
+	}
+}
+
+// captureDirections returns the diagonal directions a piece may capture in.
+func (m *CheckersModel) captureDirections(piece t.Piece) []t.Position {
+	switch {
+	case piece.Value == King || m.rules().MenCaptureBackward:
+		return diagonals
+	case piece.Color == White:
+		return forwardWhite
+	default:
+		return forwardBlack
+	}
+}
+
 // generateAllCaptureMoves finds and stores all possible capture moves for a given color.
 func (m *CheckersModel) generateAllCaptureMoves(color int8) {
-	captures := make([]t.CaptureMove, 0)
+	var paths [][]t.Position
 
-	for y := range 8 {
-		for x := range 8 {
+	for y := range m.board {
+		for x := range m.board[y] {
 			p := m.board[y][x]
 
 			// Skip empty or opponent pieces
@@ -52,57 +76,141 @@ func (m *CheckersModel) generateAllCaptureMoves(color int8) {
 				continue
 			}
 
-			from := pos(x, y)
-
-			// Determine valid directions based on piece type
-			var directions []t.Position
-			switch p.Value {
-			case Pawn:
-				dy := int(m.board[from.Y][from.X].Color)
-				directions = []t.Position{
-					{X: -1, Y: dy}, {X: 1, Y: dy},
-				}
-			case King:
-				directions = []t.Position{
-					{X: -1, Y: -1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: 1, Y: 1},
-				}
-			}
-
-			// Append any valid capture moves from this piece
-			captures = append(captures, m.addValidCaptures(from, directions)...)
+			// Append any capture sequences starting from this piece
+			paths = append(paths, m.capturePaths(pos(x, y))...)
 		}
 	}
 
-	m.captureMoves = captures
+	m.captureMoves = m.firstSteps(paths)
 }
 
-// addValidCaptures returns capture moves from a position in specified directions.
-func (m *CheckersModel) addValidCaptures(from t.Position, directions []t.Position) []t.CaptureMove {
-	moves := make([]t.CaptureMove, 0)
+// generateCaptureMoves finds and stores the jumps that continue a capture from a position.
+func (m *CheckersModel) generateCaptureMoves(from t.Position) {
+	m.captureMoves = m.firstSteps(m.capturePaths(from))
+}
 
-	for _, d := range directions {
-		mx, my := from.X+d.X, from.Y+d.Y
-		jx, jy := from.X+2*d.X, from.Y+2*d.Y
+// firstSteps returns the first jump of each capture sequence, keeping only the
+// longest sequences when the variant requires the maximum capture.
+func (m *CheckersModel) firstSteps(paths [][]t.Position) []t.CaptureMove {
+	longest := 0
+	for _, path := range paths {
+		longest = max(longest, len(path))
+	}
 
-		if !inBounds(mx, my) || !inBounds(jx, jy) {
+	moves := make([]t.CaptureMove, 0)
+	for _, path := range paths {
+		if m.rules().MaximumCapture && len(path) < longest {
 			continue
 		}
 
-		mid := m.board[my][mx]
-		dest := m.board[jy][jx]
-
-		if mid.Value != Empty && mid.Color != m.board[from.Y][from.X].Color && dest.Value == Empty {
-			moves = append(moves, t.CaptureMove{
-				From: from,
-				To:   pos(jx, jy),
-			})
+		move := t.CaptureMove{From: path[0], To: path[1]}
+		if !slices.Contains(moves, move) {
+			moves = append(moves, move)
 		}
 	}
 
 	return moves
 }
 
+// capturePaths returns every complete sequence of jumps for the piece at a position,
+// taking into account the pieces already jumped this turn.
+func (m *CheckersModel) capturePaths(from t.Position) [][]t.Position {
+	piece := m.board[from.Y][from.X]
+
+	// The moving piece no longer occupies its square while it jumps
+	m.board[from.Y][from.X] = newEmptyPiece()
+	defer func() { m.board[from.Y][from.X] = piece }()
+
+	return m.jumpPaths(from, piece, m.jumped)
+}
+
+// jumpPaths follows every chain of jumps a piece can make from a position.
+func (m *CheckersModel) jumpPaths(from t.Position, piece t.Piece, jumped []t.Position) [][]t.Position {
+	var paths [][]t.Position
+
+	for _, d := range m.captureDirections(piece) {
+		victim, landings := m.jumpsAlong(from, d, piece, jumped)
+
+		for _, to := range landings {
+			next := piece
+			if m.rules().PromoteDuringCapture && m.isPromotionRow(piece.Color, to.Y) {
+				next.Value = King
+			}
+
+			rest := m.jumpPaths(to, next, append(slices.Clone(jumped), victim))
+			if len(rest) == 0 {
+				paths = append(paths, []t.Position{from, to})
+				continue
+			}
+
+			for _, path := range rest {
+				paths = append(paths, append([]t.Position{from}, path...))
+			}
+		}
+	}
+
+	return paths
+}
+
+// jumpsAlong finds the piece that can be jumped in one direction and the squares the jumping piece may land on.
+func (m *CheckersModel) jumpsAlong(from, d t.Position, piece t.Piece, jumped []t.Position) (t.Position, []t.Position) {
+	flying := piece.Value == King && m.rules().FlyingKings
+
+	// Flying kings may jump a piece from a distance
+	x, y := from.X+d.X, from.Y+d.Y
+	for flying && m.inBounds(x, y) && m.board[y][x].Value == Empty {
+		x, y = x+d.X, y+d.Y
+	}
+
+	if !m.inBounds(x, y) {
+		return pos(-1, -1), nil
+	}
+
+	// Pieces jumped earlier in the turn stay on the board until it ends and cannot be jumped twice
+	victim := pos(x, y)
+	target := m.board[y][x]
+	if target.Value == Empty || target.Color == piece.Color || slices.Contains(jumped, victim) {
+		return victim, nil
+	}
+	if target.Value == King && piece.Value == Pawn && !m.rules().MenCaptureKings {
+		return victim, nil
+	}
+
+	var landings []t.Position
+	for x, y = x+d.X, y+d.Y; m.inBounds(x, y) && m.board[y][x].Value == Empty; x, y = x+d.X, y+d.Y {
+		landings = append(landings, pos(x, y))
+		if !flying {
+			break
+		}
+	}
+
+	return victim, landings
+}
+
+// jumpedPiece returns the position of the piece jumped by a capture from one square to another.
+func (m *CheckersModel) jumpedPiece(from, to t.Position) t.Position {
+	dx, dy := sign(to.X-from.X), sign(to.Y-from.Y)
+
+	x, y := from.X+dx, from.Y+dy
+	for (x != to.X || y != to.Y) && m.board[y][x].Value == Empty {
+		x, y = x+dx, y+dy
+	}
+
+	return pos(x, y)
+}
+
 // inBounds returns true if the given position is within the bounds of the board.
-func inBounds(x, y int) bool {
-	return x >= 0 && x < 8 && y >= 0 && y < 8
+func (m *CheckersModel) inBounds(x, y int) bool {
+	return x >= 0 && x < len(m.board) && y >= 0 && y < len(m.board)
+}
+
+// sign returns -1, 0, or 1 depending on the sign of n.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
 }
@@ -28,7 +28,22 @@ from making a move in this classic strategy game.
   which can move both forward and backward.
 
 The game ends when one player has no pieces left or
-no legal moves remaining.`
+no legal moves remaining. It is drawn after 40 moves each
+without a capture, when a position repeats three times,
+or by agreement.
+
+Play against a friend on the same keyboard or against the
+computer. Pick your side and how far ahead it looks from
+the setup screen.
+
+The setup screen also offers other rule sets:
+• International - 10x10 board, flying kings, men capture
+  backwards, and the longest capture must be taken.
+• Russian - Flying kings, men capture backwards, and a man
+  crowned mid-capture keeps jumping as a king.
+• Brazilian - International rules on an 8x8 board.
+• Italian - Men cannot capture kings, and the longest
+  capture must be taken.`
 
 	Rendering = `There are 3 different styles of rendering:
 • Block - Pieces drawn using block characters
@@ -62,7 +77,16 @@ func (m *CheckersModel) Help() string {
 	// Define keybindings specific to the game
 	gameKeybinds := []components.Keybind{
 		{Key: "click", Action: "select"},
+		{Key: "↑ ↓ ← → / hjkl", Action: "move cursor"},
+		{Key: "enter / space", Action: "select / move"},
 		{Key: "1 / 2 / 3", Action: "change renderer"},
+		{Key: "ctrl+n", Action: "game settings"},
+		{Key: "u / ctrl+z", Action: "undo move"},
+		{Key: "U / ctrl+shift+z", Action: "redo move"},
+		{Key: "ctrl+d", Action: "offer draw"},
+		{Key: "ctrl+x", Action: "resign"},
+		{Key: "ctrl+e", Action: "save game as PDN"},
+		{Key: "← / →", Action: "step through replay"},
 	}
 
 	keybinds := components.JoinKeybinds(
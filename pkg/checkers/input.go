@@ -0,0 +1,43 @@
+package checkers
+
+import (
+	tea "charm.land/bubbletea/v2"
+)
+
+// handleBoardKey handles keyboard play: moving the cursor and selecting squares.
+func (m *CheckersModel) handleBoardKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if m.gameOver {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		m.moveCursor(0, -1)
+	case "down", "j":
+		m.moveCursor(0, 1)
+	case "left", "h":
+		m.moveCursor(-1, 0)
+	case "right", "l":
+		m.moveCursor(1, 0)
+	case "enter", "space":
+		// The first key press only reveals the cursor
+		if !m.showCursor {
+			m.showCursor = true
+			return m, nil
+		}
+		return m.handleSquare(m.cursor)
+	}
+
+	return m, nil
+}
+
+// moveCursor moves the board cursor, showing it at its last position first.
+func (m *CheckersModel) moveCursor(dx, dy int) {
+	if !m.showCursor {
+		m.showCursor = true
+		return
+	}
+
+	m.cursor.X = min(max(m.cursor.X+dx, 0), len(m.board)-1)
+	m.cursor.Y = min(max(m.cursor.Y+dy, 0), len(m.board)-1)
+}
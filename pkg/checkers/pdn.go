@@ -0,0 +1,461 @@
+package checkers
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	t "ascii-arcade/pkg/checkers/types"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const gamesFile = "data/checkers/games.pdn"
+
+var (
+	tagPattern    = regexp.MustCompile(`^\[(\w+)\s+"((?:[^"\\]|\\.)*)"\]$`)
+	numberPattern = regexp.MustCompile(`^\d+\.+`)
+	movePattern   = regexp.MustCompile(`^\d+([-x]\d+)+$`)
+	results       = []string{"1-0", "0-1", "1/2-1/2", "2-0", "0-2", "1-1", "0-0", "*"}
+)
+
+// replay steps through the positions of an imported game.
+type replay struct {
+	positions []*CheckersModel
+	index     int
+}
+
+// LoadPDNFile opens the first game in a PDN file for replay.
+func LoadPDNFile(path string) (*CheckersModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading PDN file: %v", err)
+	}
+	return InitCheckersModelFromPDN(string(data))
+}
+
+// InitCheckersModelFromPDN plays through a PDN game and opens it for replay from the start.
+func InitCheckersModelFromPDN(pdn string) (*CheckersModel, error) {
+	tags, moves, err := parsePDN(pdn)
+	if err != nil {
+		return nil, err
+	}
+
+	if tags["FEN"] != "" {
+		return nil, fmt.Errorf("PDN games from a set up position are not supported")
+	}
+
+	variant, err := variantForGameType(tags["GameType"])
+	if err != nil {
+		return nil, err
+	}
+
+	m := newGame(Ascii, variant, false, White, defaultDepth)
+
+	// Some files start with a move by the side on the upper squares
+	if len(moves) > 0 {
+		if from, ok := m.squarePosition(leadingSquare(moves[0])); ok && m.board[from.Y][from.X].Color == Black {
+			m.turn = Black
+			m.startTurn = Black
+			m.generateAllCaptureMoves(m.turn)
+			m.positionHistory = []uint64{m.hash()}
+		}
+	}
+
+	// Record every position so the game can be stepped through in both directions
+	positions := []*CheckersModel{m.snapshot()}
+	for i, text := range moves {
+		// Rule based draws are claimed over the board, so recorded games may play on
+		if m.gameOver && !m.whiteWins && !m.blackWins {
+			m.gameOver = false
+			m.endReason = ""
+		}
+
+		if m.gameOver {
+			return nil, fmt.Errorf("move %d played after the game ended: %q", i+1, text)
+		}
+
+		path, err := m.parseMove(text)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := 1; j < len(path); j++ {
+			m.handleMovePiece(path[j-1], path[j])
+		}
+		positions = append(positions, m.snapshot())
+	}
+
+	start := positions[0].clone()
+	start.replay = &replay{positions: positions}
+	return start, nil
+}
+
+// variantForGameType returns the variant for a PDN GameType tag, defaulting to American checkers.
+func variantForGameType(tag string) (int, error) {
+	if tag == "" {
+		return 0, nil
+	}
+
+	// The tag may carry extra board details after the game type
+	code, _, _ := strings.Cut(tag, ",")
+	gameType, err := strconv.Atoi(strings.TrimSpace(code))
+	if err != nil {
+		return 0, fmt.Errorf("invalid PDN game type: %q", tag)
+	}
+
+	for i, v := range Variants {
+		if v.GameType == gameType {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported PDN game type: %d", gameType)
+}
+
+// parsePDN splits the first game of a PDN file into its tags and moves.
+func parsePDN(pdn string) (map[string]string, []string, error) {
+	tags := map[string]string{}
+
+	var movetext strings.Builder
+	for line := range strings.Lines(pdn) {
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			// A tag after the movetext starts the next game
+			if movetext.Len() > 0 {
+				break
+			}
+
+			match := tagPattern.FindStringSubmatch(line)
+			if match == nil {
+				return nil, nil, fmt.Errorf("invalid PDN tag: %q", line)
+			}
+			tags[match[1]] = strings.ReplaceAll(match[2], `\"`, `"`)
+			continue
+		}
+
+		movetext.WriteString(line + "\n")
+	}
+
+	moves, err := parseMovetext(movetext.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, moves, nil
+}
+
+// parseMovetext extracts the main line moves, skipping comments, variations, and annotations.
+func parseMovetext(text string) ([]string, error) {
+	var moves []string
+	var token strings.Builder
+	depth := 0
+
+	flush := func() {
+		move := numberPattern.ReplaceAllString(token.String(), "")
+		move = strings.TrimRight(move, "!?")
+		token.Reset()
+
+		if move == "" || strings.HasPrefix(move, "$") || slices.Contains(results, move) {
+			return
+		}
+		moves = append(moves, move)
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		// Brace comments run until the closing brace
+		case c == '{':
+			end := strings.IndexByte(text[i:], '}')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated PDN comment")
+			}
+			flush()
+			i += end
+
+		// Variations can be nested and are skipped entirely
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("unbalanced PDN variation")
+			}
+			depth--
+
+		case depth > 0:
+			continue
+
+		case c == ' ' || c == '\n' || c == '\t' || c == '\r':
+			flush()
+
+		default:
+			token.WriteByte(c)
+		}
+	}
+	flush()
+
+	return moves, nil
+}
+
+// parseMove finds the legal move described by a move in numeric notation, such as
+// "11-15", "22x15x8", or the shortened capture "22x8".
+func (m *CheckersModel) parseMove(text string) ([]t.Position, error) {
+	if !movePattern.MatchString(text) {
+		return nil, fmt.Errorf("invalid move: %q", text)
+	}
+
+	var squares []t.Position
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == '-' || r == 'x' }) {
+		n, _ := strconv.Atoi(field)
+		p, ok := m.squarePosition(n)
+		if !ok {
+			return nil, fmt.Errorf("invalid square %d in move %q", n, text)
+		}
+		squares = append(squares, p)
+	}
+
+	var matches []move
+	for _, mv := range m.legalMoves() {
+		first, last := mv.path[0], mv.path[len(mv.path)-1]
+		if first != squares[0] || last != squares[len(squares)-1] {
+			continue
+		}
+
+		// Intermediate squares, when given, must match the full path
+		if len(squares) > 2 && !slices.Equal(mv.path, squares) {
+			continue
+		}
+		matches = append(matches, mv)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("illegal move: %q", text)
+	}
+
+	// A shortened capture is only ambiguous if the routes capture different pieces
+	for _, mv := range matches[1:] {
+		if mv.next.hash() != matches[0].next.hash() {
+			return nil, fmt.Errorf("ambiguous move: %q", text)
+		}
+	}
+
+	return matches[0].path, nil
+}
+
+// leadingSquare returns the square a move in numeric notation starts from.
+func leadingSquare(text string) int {
+	end := strings.IndexAny(text, "-x")
+	if end == -1 {
+		end = len(text)
+	}
+	n, _ := strconv.Atoi(text[:end])
+	return n
+}
+
+// squareName returns the PDN square number of a playable square as text.
+func (m *CheckersModel) squareName(p t.Position) string {
+	return strconv.Itoa(m.squareNumber(p))
+}
+
+// squareNumber returns the PDN number of a playable square. Squares are counted row by
+// row from the top of the board, left to right, except in American checkers where they
+// are counted from the bottom so the side moving first starts on squares 1 to 12.
+func (m *CheckersModel) squareNumber(p t.Position) int {
+	n := p.Y*len(m.board)/2 + p.X/2 + 1
+	if m.rules().FirstPlayerBlack {
+		n = len(m.board)*len(m.board)/2 + 1 - n
+	}
+	return n
+}
+
+// squarePosition returns the board position of a PDN square number.
+func (m *CheckersModel) squarePosition(n int) (t.Position, bool) {
+	perRow := len(m.board) / 2
+	if n < 1 || n > perRow*len(m.board) {
+		return pos(-1, -1), false
+	}
+
+	if m.rules().FirstPlayerBlack {
+		n = perRow*len(m.board) + 1 - n
+	}
+
+	y := (n - 1) / perRow
+	x := (n - 1) % perRow * 2
+
+	// Playable squares are shifted one column on even rows
+	if y%2 == 0 {
+		x++
+	}
+	return pos(x, y), true
+}
+
+// PDN encodes the game played so far, including its tags.
+func (m *CheckersModel) PDN() string {
+	white, black := "Player 1", "Player 2"
+	if m.vsComputer {
+		computer := fmt.Sprintf("ascii-arcade (depth %d)", m.depth)
+		white, black = "Player", computer
+		if m.playerColor == Black {
+			white, black = computer, "Player"
+		}
+	}
+
+	// American PDN calls the side that moves first Black
+	if m.rules().FirstPlayerBlack {
+		white, black = black, white
+	}
+
+	var b strings.Builder
+	writeTag := func(name, value string) {
+		fmt.Fprintf(&b, "[%s \"%s\"]\n", name, strings.ReplaceAll(value, `"`, `\"`))
+	}
+
+	writeTag("Event", "Casual Game")
+	writeTag("Site", "ascii-arcade")
+	writeTag("Date", time.Now().Format("2006.01.02"))
+	writeTag("Round", "-")
+	writeTag("White", white)
+	writeTag("Black", black)
+	writeTag("Result", m.result())
+	writeTag("GameType", strconv.Itoa(m.rules().GameType))
+	b.WriteString("\n")
+
+	// Wrap the movetext at 80 columns
+	line := ""
+	for _, token := range append(m.movetextTokens(), m.result()) {
+		if line != "" && len(line)+1+len(token) > 80 {
+			b.WriteString(line + "\n")
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += token
+	}
+	b.WriteString(line + "\n")
+
+	return b.String()
+}
+
+// movetextTokens returns the moves of the game with move numbers.
+func (m *CheckersModel) movetextTokens() []string {
+	var tokens []string
+	for _, row := range m.moveRows(-1) {
+		// Keep move numbers on the same line as their move
+		switch {
+		case row.Moves[0] == "":
+			tokens = append(tokens, fmt.Sprintf("%d... %s", row.Number, row.Moves[1]))
+		case row.Moves[1] == "":
+			tokens = append(tokens, fmt.Sprintf("%d. %s", row.Number, row.Moves[0]))
+		default:
+			tokens = append(tokens, fmt.Sprintf("%d. %s", row.Number, row.Moves[0]), row.Moves[1])
+		}
+	}
+	return tokens
+}
+
+// moveRows pairs the move history into numbered rows, marking the move at index current.
+// The first column holds the moves of the side that started the game.
+func (m *CheckersModel) moveRows(current int) []t.MoveRow {
+	var rows []t.MoveRow
+
+	number := 1
+	side := 0
+	for i, text := range m.moveHistory {
+		if side == 0 {
+			rows = append(rows, t.MoveRow{Number: number, Current: -1})
+		}
+
+		row := &rows[len(rows)-1]
+		row.Moves[side] = text
+		if i == current {
+			row.Current = side
+		}
+
+		// Move numbers advance after the second side has moved
+		if side == 1 {
+			number++
+		}
+		side = 1 - side
+	}
+
+	return rows
+}
+
+// result returns the PDN result of the game.
+func (m *CheckersModel) result() string {
+	whiteResult, blackResult := "1-0", "0-1"
+
+	// American PDN names the colours the other way round
+	if m.rules().FirstPlayerBlack {
+		whiteResult, blackResult = blackResult, whiteResult
+	}
+
+	switch {
+	case m.whiteWins:
+		return whiteResult
+	case m.blackWins:
+		return blackResult
+	case m.gameOver:
+		return "1/2-1/2"
+	}
+	return "*"
+}
+
+// saveGame appends the game to the PDN games file.
+func (m *CheckersModel) saveGame() error {
+	if err := os.MkdirAll("data/checkers", 0755); err != nil {
+		return fmt.Errorf("error creating data dir: %v", err)
+	}
+
+	f, err := os.OpenFile(gamesFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening games file: %v", err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintln(f, m.PDN()); err != nil {
+		return fmt.Errorf("error writing game: %v", err)
+	}
+	return nil
+}
+
+// handleReplayKey steps through an imported game.
+func (m *CheckersModel) handleReplayKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	r := m.replay
+	last := len(r.positions) - 1
+
+	switch msg.String() {
+	case "left", "h", "a":
+		r.index = max(r.index-1, 0)
+	case "right", "l", "d":
+		r.index = min(r.index+1, last)
+	case "home", "up", "k", "w":
+		r.index = 0
+	case "end", "down", "j", "s":
+		r.index = last
+	case "enter":
+		// Continue playing from the shown position
+		next := r.positions[r.index].clone()
+		next.renderer = m.renderer
+		next.replay = nil
+		return next, nil
+	}
+
+	return m, nil
+}
+
+// replayPosition returns the position currently shown by the replay.
+func (m *CheckersModel) replayPosition() *CheckersModel {
+	return m.replay.positions[m.replay.index]
+}
@@ -27,6 +27,7 @@ var (
 	CSelected = colors.Blue
 	CTake     = colors.Pink
 	CCheck    = colors.Red
+	CCursor   = colors.Medium1
 
 	WhitePiece    = lipgloss.NewStyle().Foreground(CWhite).Bold(true)
 	BlackPiece    = lipgloss.NewStyle().Foreground(CBlack).Bold(true)
@@ -44,4 +45,24 @@ var (
 
 	EvenCell = EmptyCell.
 			Background(colors.Light2)
+
+	CursorCell = EmptyCell.
+			Background(CCursor)
+
+	MoveListRows = 30
+
+	MoveList = lipgloss.NewStyle().
+			MarginLeft(2).
+			Width(28)
+
+	MoveListHeader = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	MoveNumber  = lipgloss.NewStyle().Foreground(colors.Medium2)
+	MoveText    = lipgloss.NewStyle().Foreground(colors.Light2)
+	CurrentMove = lipgloss.NewStyle().Foreground(colors.Dark1).Background(CSelected).Bold(true)
 )
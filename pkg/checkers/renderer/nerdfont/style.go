@@ -26,6 +26,7 @@ var (
 	CSelected = colors.Blue
 	CTake     = colors.Pink
 	CCheck    = colors.Red
+	CCursor   = colors.Medium1
 
 	WhitePiece    = lipgloss.NewStyle().Foreground(CWhite).Bold(true)
 	BlackPiece    = lipgloss.NewStyle().Foreground(CBlack).Bold(true)
@@ -43,6 +44,9 @@ var (
 	EvenCell = EmptyCell.
 			Background(colors.Light2)
 
+	CursorCell = EmptyCell.
+			Background(CCursor)
+
 	MarginEven = lipgloss.NewStyle().
 			Background(colors.Dark1).
 			Foreground(colors.Light2)
@@ -56,4 +60,22 @@ var (
 
 	EndMarginOdd = lipgloss.NewStyle().
 			Foreground(colors.Dark1)
+
+	MoveListRows = 14
+
+	MoveList = lipgloss.NewStyle().
+			MarginTop(1).
+			MarginLeft(2).
+			Width(28)
+
+	MoveListHeader = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	MoveNumber  = lipgloss.NewStyle().Foreground(colors.Medium2)
+	MoveText    = lipgloss.NewStyle().Foreground(colors.Light2)
+	CurrentMove = lipgloss.NewStyle().Foreground(colors.Dark1).Background(CSelected).Bold(true)
 )
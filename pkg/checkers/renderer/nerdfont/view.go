@@ -4,6 +4,7 @@ import (
 	t "ascii-arcade/pkg/checkers/types"
 	"fmt"
 	"image/color"
+	"strings"
 
 	"charm.land/lipgloss/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -54,8 +55,13 @@ func (r NerdfontRenderer) View() string {
 		pieces[move.From.Y][move.From.X] = r.viewPiece(from.Value, SelectedPiece)
 	}
 
+	// Highlight pieces captured so far this turn
+	for _, p := range r.Jumped {
+		pieces[p.Y][p.X] = r.viewPiece(r.Board[p.Y][p.X].Value, TakePiece)
+	}
+
 	// Assemble the full view
-	return r.viewBoard(pieces)
+	return lipgloss.JoinHorizontal(lipgloss.Top, r.viewBoard(pieces), r.viewMoveList())
 }
 
 // viewBoard renders a grid of strings into a styled checkersboard.
@@ -69,6 +75,11 @@ func (r NerdfontRenderer) viewBoard(board [][]string) string {
 			isEven := (x+y)%2 == 0
 			style := styleCell(isEven, EvenCell, OddCell)
 
+			// Highlight the keyboard cursor
+			if r.Cursor == (t.Position{X: x, Y: y}) {
+				style = CursorCell
+			}
+
 			// Use a unique zone label for mouse interactivity
 			label := fmt.Sprint(y*len(board) + x)
 			row[x] = zone.Mark(label, style.Render(content))
@@ -88,18 +99,71 @@ func (r NerdfontRenderer) viewMarginRow(y int) string {
 	for x := range len(r.Board) {
 		isEven := (x+y)%2 == 0
 
+		// Half blocks take the color of the cursor cell they border
+		below := r.Cursor == (t.Position{X: x, Y: y})
+		above := r.Cursor == (t.Position{X: x, Y: y - 1})
+
 		if y == 0 {
-			top[x] = styleCell(isEven, EndMarginEven, EndMarginOdd).Render("▄▄▄▄▄")
+			style := styleCell(isEven, EndMarginEven, EndMarginOdd)
+			if below {
+				style = style.Foreground(CCursor)
+			}
+			top[x] = style.Render("▄▄▄▄▄")
 		} else if y == len(r.Board) {
-			top[x] = styleCell(!isEven, EndMarginEven, EndMarginOdd).Render("▀▀▀▀▀")
+			style := styleCell(!isEven, EndMarginEven, EndMarginOdd)
+			if above {
+				style = style.Foreground(CCursor)
+			}
+			top[x] = style.Render("▀▀▀▀▀")
 		} else {
-			top[x] = styleCell(isEven, MarginEven, MarginOdd).Render("▄▄▄▄▄")
+			style := styleCell(isEven, MarginEven, MarginOdd)
+			if below {
+				style = style.Foreground(CCursor)
+			}
+			if above {
+				style = style.Background(CCursor)
+			}
+			top[x] = style.Render("▄▄▄▄▄")
 		}
 	}
 
 	return lipgloss.JoinHorizontal(lipgloss.Bottom, top[:]...)
 }
 
+// viewMoveList renders the most recent moves, keeping the current move in view.
+func (r NerdfontRenderer) viewMoveList() string {
+	rows := r.MoveRows
+
+	// Scroll so the current move is the last visible row
+	end := len(rows)
+	for i, row := range rows {
+		if row.Current != -1 {
+			end = i + 1
+		}
+	}
+	start := max(end-MoveListRows, 0)
+
+	lines := []string{MoveListHeader.Render("Moves")}
+	for _, row := range rows[start:end] {
+		line := MoveNumber.Render(fmt.Sprintf("%3d. ", row.Number))
+		for side, move := range row.Moves {
+			if move == "" && side == 0 {
+				move = "..."
+			}
+
+			cell := fmt.Sprintf("%-11s", move)
+			if row.Current == side {
+				line += CurrentMove.Render(cell)
+			} else {
+				line += MoveText.Render(cell)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return MoveList.Render(strings.Join(lines, "\n"))
+}
+
 // viewPiece renders a single given checkers piece.
 func (r NerdfontRenderer) viewPiece(piece int8, style lipgloss.Style) string {
 	switch piece {
@@ -0,0 +1,30 @@
+package checkers
+
+import (
+	"ascii-arcade/internal/colors"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	LabelStyle = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	ListEntry = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			MarginLeft(2)
+
+	SelectedListEntry = lipgloss.NewStyle().
+				Foreground(colors.Pink)
+
+	ListDetail = lipgloss.NewStyle().
+			Foreground(colors.Medium2)
+
+	MessageStyle = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			Bold(true)
+)
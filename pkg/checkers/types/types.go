@@ -13,9 +13,19 @@ type CaptureMove struct {
 	From, To Position
 }
 
+type MoveRow struct {
+	Number  int
+	Moves   [2]string
+	Current int
+}
+
 type RenderContext struct {
 	Board        [][]Piece
 	Selected     Position
+	Cursor       Position
 	ValidMoves   []Position
 	CaptureMoves []CaptureMove
+	Jumped       []Position
+
+	MoveRows []MoveRow
 }
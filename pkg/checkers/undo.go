@@ -0,0 +1,72 @@
+package checkers
+
+import tea "charm.land/bubbletea/v2"
+
+// pushUndo stores the current game state before a move and clears the redo history.
+func (m *CheckersModel) pushUndo() {
+	m.undoStack = append(m.undoStack, m.snapshot())
+	m.redoStack = nil
+}
+
+// handleUndo takes back the last move, one jump at a time during a multi-jump capture.
+// Against the computer it keeps going back until it is the player's move again.
+func (m *CheckersModel) handleUndo() tea.Cmd {
+	for len(m.undoStack) > 0 {
+		last := len(m.undoStack) - 1
+		prev := m.undoStack[last]
+		m.undoStack = m.undoStack[:last]
+
+		m.redoStack = append(m.redoStack, m.snapshot())
+		m.restore(prev)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// handleRedo replays the last move that was taken back.
+func (m *CheckersModel) handleRedo() tea.Cmd {
+	for len(m.redoStack) > 0 {
+		last := len(m.redoStack) - 1
+		next := m.redoStack[last]
+		m.redoStack = m.redoStack[:last]
+
+		m.undoStack = append(m.undoStack, m.snapshot())
+		m.restore(next)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// snapshot returns a copy of the game state for the undo history.
+func (m *CheckersModel) snapshot() *CheckersModel {
+	s := m.clone()
+	s.undoStack = nil
+	s.redoStack = nil
+	s.thinking = false
+	return s
+}
+
+// restore replaces the game state with a snapshot, keeping the history and display settings.
+func (m *CheckersModel) restore(s *CheckersModel) {
+	undoStack, redoStack := m.undoStack, m.redoStack
+	renderer, searchSeq := m.renderer, m.searchSeq
+	cursor, showCursor := m.cursor, m.showCursor
+
+	*m = *s.clone()
+	m.undoStack = undoStack
+	m.redoStack = redoStack
+	m.renderer = renderer
+	m.cursor = cursor
+	m.showCursor = showCursor
+
+	// Drop any search still running for the position that was left
+	m.searchSeq = searchSeq + 1
+}
@@ -0,0 +1,96 @@
+package checkers
+
+// Variant describes the board and capture rules of a checkers variant.
+type Variant struct {
+	Name string
+
+	// GameType is the PDN code identifying the variant in saved games
+	GameType int
+
+	// Size is the number of squares along each side of the board
+	Size int
+
+	// Rows is the number of rows of men each side starts with
+	Rows int
+
+	// FlyingKings lets kings move and capture any distance along a diagonal
+	FlyingKings bool
+
+	// MenCaptureBackward lets men capture backwards as well as forwards
+	MenCaptureBackward bool
+
+	// MenCaptureKings allows men to jump over kings
+	MenCaptureKings bool
+
+	// MaximumCapture obliges a player to take the sequence capturing the most pieces
+	MaximumCapture bool
+
+	// PromoteDuringCapture crowns a man as soon as it reaches the far row, letting it continue as a king
+	PromoteDuringCapture bool
+
+	// FirstPlayerBlack follows the American PDN convention, where the side moving first is
+	// called Black and its men start on squares 1 to 12
+	FirstPlayerBlack bool
+}
+
+// Variants lists the rule sets that can be chosen on the setup screen.
+var Variants = []Variant{
+	{
+		Name:             "American",
+		GameType:         21,
+		Size:             8,
+		Rows:             3,
+		MenCaptureKings:  true,
+		FirstPlayerBlack: true,
+	},
+	{
+		Name:               "International",
+		GameType:           20,
+		Size:               10,
+		Rows:               4,
+		FlyingKings:        true,
+		MenCaptureBackward: true,
+		MenCaptureKings:    true,
+		MaximumCapture:     true,
+	},
+	{
+		Name:                 "Russian",
+		GameType:             25,
+		Size:                 8,
+		Rows:                 3,
+		FlyingKings:          true,
+		MenCaptureBackward:   true,
+		MenCaptureKings:      true,
+		PromoteDuringCapture: true,
+	},
+	{
+		Name:               "Brazilian",
+		GameType:           26,
+		Size:               8,
+		Rows:               3,
+		FlyingKings:        true,
+		MenCaptureBackward: true,
+		MenCaptureKings:    true,
+		MaximumCapture:     true,
+	},
+	{
+		Name:           "Italian",
+		GameType:       22,
+		Size:           8,
+		Rows:           3,
+		MaximumCapture: true,
+	},
+}
+
+// rules returns the variant being played.
+func (m *CheckersModel) rules() Variant {
+	return Variants[m.variant]
+}
+
+// isPromotionRow reports whether a row is the far row for men of the given color.
+func (m *CheckersModel) isPromotionRow(color int8, y int) bool {
+	if color == White {
+		return y == 0
+	}
+	return y == len(m.board)-1
+}
@@ -7,10 +7,12 @@ import (
 	"ascii-arcade/pkg/checkers/renderer/block"
 	"ascii-arcade/pkg/checkers/renderer/nerdfont"
 	t "ascii-arcade/pkg/checkers/types"
+	"fmt"
 	"image/color"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
 )
 
 type PieceRenderer interface {
@@ -24,17 +26,104 @@ const (
 	Nerdfont
 )
 
-// View renders the entire Chess board.
+// View renders the entire Checkers board.
 func (m *CheckersModel) View() tea.View {
+	if !m.hasSelected {
+		return tea.NewView(m.viewSetup())
+	}
+
+	if m.replay != nil {
+		return tea.NewView(m.viewReplay())
+	}
+
 	renderer := m.getPieceRenderer(m.renderer)
 
 	if m.gameOver {
 		return tea.NewView(m.viewGameOver(renderer))
 	}
 
+	if status := m.viewStatus(); status != "" {
+		return tea.NewView(lipgloss.JoinVertical(
+			lipgloss.Center,
+			renderer.View(),
+			MessageStyle.Render(status),
+		))
+	}
+
 	return tea.NewView(renderer.View())
 }
 
+// viewStatus returns the line shown below the board, if any.
+func (m *CheckersModel) viewStatus() string {
+	switch {
+	case m.message != "":
+		return m.message
+	case m.thinking:
+		return colorName(m.turn) + " is thinking..."
+	}
+	return ""
+}
+
+// viewReplay renders the current position of an imported game with the full move list.
+func (m *CheckersModel) viewReplay() string {
+	final := m.replay.positions[len(m.replay.positions)-1]
+
+	context := m.replayPosition().renderContext()
+	context.MoveRows = final.moveRows(m.replay.index - 1)
+
+	status := fmt.Sprintf("Move %d of %d · ←/→ step · enter to play from here",
+		m.replay.index, len(m.replay.positions)-1)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		newPieceRenderer(m.renderer, context).View(),
+		MessageStyle.Render(status),
+	)
+}
+
+// viewSetup renders the game settings menu.
+func (m *CheckersModel) viewSetup() string {
+	mode := "Two players"
+	if m.vsComputer {
+		mode = "Computer"
+	}
+
+	v := m.rules()
+	entries := []string{
+		m.viewSetupEntry(setupVariant, "Rules", fmt.Sprintf("%s (%dx%d)", v.Name, v.Size, v.Size)),
+		m.viewSetupEntry(setupMode, "Opponent", mode),
+	}
+	if m.vsComputer {
+		entries = append(entries,
+			m.viewSetupEntry(setupSide, "Play as", colorName(m.playerColor)),
+			m.viewSetupEntry(setupDepth, "Depth", fmt.Sprintf("%d moves ahead", m.depth)),
+		)
+	}
+	entries = append(entries, m.viewSetupEntry(setupStart, "Start", ""))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		LabelStyle.Render("Checkers"),
+		lipgloss.JoinVertical(lipgloss.Left, entries...),
+	)
+}
+
+// viewSetupEntry renders a single row of the settings menu.
+func (m *CheckersModel) viewSetupEntry(row int, name, value string) string {
+	detail := "\n"
+	if value != "" {
+		detail = ListDetail.Render("‹ "+value+" ›") + "\n"
+	}
+
+	var entry string
+	if m.setupRow == row {
+		entry = SelectedListEntry.Render("> "+name) + "\n  " + detail
+	} else {
+		entry = ListEntry.Render(name + "\n" + detail)
+	}
+
+	return zone.Mark(fmt.Sprintf("setup_%d", row), entry)
+}
+
 // viewGameOver renders the end of game UI.
 func (m *CheckersModel) viewGameOver(renderer PieceRenderer) string {
 	// Determine game outcome and assign appropriate styling
@@ -48,23 +137,40 @@ func (m *CheckersModel) viewGameOver(renderer PieceRenderer) string {
 		winner = "Black wins!"
 		color = colors.Purple
 	default:
-		winner = "Stalemate!"
+		winner = "Draw!"
 		color = colors.Blue
 	}
 
 	winner = lipgloss.NewStyle().Foreground(color).Render(winner)
-	return components.GameOver(color, renderer.View(), winner)
+	return components.GameOver(color, renderer.View(), winner, m.endReason)
 }
 
 // getPieceRenderer returns the appropriate piece renderer.
 func (m *CheckersModel) getPieceRenderer(renderer int) PieceRenderer {
-	context := t.RenderContext{
+	return newPieceRenderer(renderer, m.renderContext())
+}
+
+// renderContext collects the game state needed by the renderers.
+func (m *CheckersModel) renderContext() t.RenderContext {
+	cursor := pos(-1, -1)
+	if m.showCursor {
+		cursor = m.cursor
+	}
+
+	return t.RenderContext{
 		Board:        m.board,
 		Selected:     m.selected,
+		Cursor:       cursor,
 		ValidMoves:   m.validMoves,
 		CaptureMoves: m.captureMoves,
+		Jumped:       m.jumped,
+
+		MoveRows: m.moveRows(len(m.moveHistory) - 1),
 	}
+}
 
+// newPieceRenderer creates the piece renderer for the given style.
+func newPieceRenderer(renderer int, context t.RenderContext) PieceRenderer {
 	switch renderer {
 	case Block:
 		return block.BlockRenderer{RenderContext: context}
@@ -76,3 +182,11 @@ func (m *CheckersModel) getPieceRenderer(renderer int) PieceRenderer {
 
 	return nil
 }
+
+// colorName returns the display name for a side.
+func colorName(color int8) string {
+	if color == White {
+		return "White"
+	}
+	return "Black"
+}
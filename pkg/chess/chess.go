@@ -5,6 +5,7 @@ import (
 	"slices"
 
 	t "ascii-arcade/pkg/chess/types"
+	"ascii-arcade/pkg/registry"
 
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -26,6 +27,14 @@ const (
 	Black = 1
 )
 
+// Rows of the setup screen.
+const (
+	setupMode = iota
+	setupSide
+	setupStrength
+	setupStart
+)
+
 type ChessModel struct {
 	renderer   int
 	board      [][]t.Piece
@@ -33,6 +42,12 @@ type ChessModel struct {
 	validMoves []t.Position
 	turn       int8
 
+	// Keyboard play
+	cursor     t.Position
+	showCursor bool
+	typing     bool
+	input      string
+
 	whiteCapturedPieces []t.Piece
 	blackCapturedPieces []t.Piece
 
@@ -43,30 +58,102 @@ type ChessModel struct {
 
 	enPassantTarget *t.Position
 
+	halfmoveClock  int
+	fullmoveNumber int
+
+	// Hashes of every position reached, for threefold repetition
+	positionHistory []uint64
+
+	// Move history in Standard Algebraic Notation
+	moveHistory   []string
+	pendingMove   string
+	startFullmove int
+	startTurn     int8
+	replay        *replay
+
+	// Snapshots of earlier positions for undo and redo
+	undoStack []*ChessModel
+	redoStack []*ChessModel
+
 	isWhiteKingInCheck bool
 	isBlackKingInCheck bool
 
 	pawnPromotionTarget *t.Position
 
+	// Setup
+	startFEN    string
+	hasSelected bool
+	setupRow    int
+	vsComputer  bool
+	playerColor int8
+	strength    int
+
+	// Computer opponent
+	thinking  bool
+	searchSeq int
+
+	message string
+
+	drawOffer int8
+	endReason string
+
 	whiteWins bool
 	blackWins bool
 	stalemate bool
 	gameOver  bool
 }
 
-// InitChessModel creates and initializes a new chess model.
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Chess",
+		Category: registry.Strategy,
+		Order:    2,
+		New:      func() tea.Model { return InitChessModel() },
+		Launchers: []registry.Launcher{
+			{
+				Flag:  "pgn",
+				Usage: "Replay the first chess game in a PGN file",
+				Open:  func(path string) (tea.Model, error) { return LoadPGNFile(path) },
+			},
+			{
+				Flag:  "fen",
+				Usage: "Start chess from a FEN position",
+				Open:  func(fen string) (tea.Model, error) { return InitChessModelFromFEN(fen) },
+			},
+		},
+	})
+}
+
+// InitChessModel creates a chess model on the setup screen.
 func InitChessModel() *ChessModel {
+	m := newGame(Block, false, White, defaultStrength)
+	m.hasSelected = false
+	return m
+}
+
+// newGame creates and initializes a new chess game with the given settings.
+func newGame(renderer int, vsComputer bool, playerColor int8, strength int) *ChessModel {
 	m := ChessModel{
-		renderer: Block,
+		renderer: renderer,
 		board:    InitChessBoard(),
 		selected: pos(-1, -1),
+		cursor:   defaultCursor(playerColor),
 		turn:     White,
 
+		hasSelected: true,
+		vsComputer:  vsComputer,
+		playerColor: playerColor,
+		strength:    strength,
+
 		whiteCastleKingside:  true,
 		whiteCastleQueenside: true,
 		blackCastleKingside:  true,
 		blackCastleQueenside: true,
 
+		fullmoveNumber: 1,
+		startFullmove:  1,
+		startTurn:      White,
+
 		isWhiteKingInCheck: false,
 		isBlackKingInCheck: false,
 
@@ -77,6 +164,7 @@ func InitChessModel() *ChessModel {
 		stalemate: false,
 		gameOver:  false,
 	}
+	m.positionHistory = []uint64{m.hash()}
 
 	return &m
 }
@@ -134,14 +222,33 @@ func (m *ChessModel) Init() tea.Cmd {
 	return nil
 }
 
-// Update handles keypress and mouse events to update the Chess game state.
+// Update handles keypress, mouse, and engine events to update the Chess game state.
 func (m *ChessModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case engineMoveMsg:
+		return m.handleEngineMove(msg)
+
 	// Handle keyboard input
 	case tea.KeyPressMsg:
+		// Typed moves take every key until they are submitted or cancelled
+		if m.typing {
+			return m.handleInputKey(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+r":
-			return InitChessModel(), nil
+			return m.restart()
+		case "ctrl+n":
+			next := InitChessModel()
+			if m.startFEN != "" {
+				next.loadFEN(m.startFEN)
+				next.startFEN = m.startFEN
+			}
+			next.renderer = m.renderer
+			next.vsComputer = m.vsComputer
+			next.playerColor = m.playerColor
+			next.strength = m.strength
+			return next, nil
 		case "1":
 			m.renderer = Block
 			return m, nil
@@ -153,6 +260,58 @@ func (m *ChessModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if !m.hasSelected {
+			return m.handleSetupKey(msg)
+		}
+
+		if m.replay != nil {
+			return m.handleReplayKey(msg)
+		}
+
+		// Answer a pending draw offer
+		if m.drawOffer != Empty && !m.gameOver {
+			switch msg.String() {
+			case "y":
+				m.handleDrawResponse(true)
+				return m, nil
+			case "n":
+				m.handleDrawResponse(false)
+				return m, nil
+			}
+		}
+
+		switch msg.String() {
+		case "u", "ctrl+z":
+			return m, m.handleUndo()
+		case "U", "ctrl+shift+z":
+			return m, m.handleRedo()
+		case "ctrl+y":
+			m.message = "FEN copied to clipboard."
+			return m, tea.SetClipboard(m.FEN())
+		case "ctrl+f":
+			if err := m.savePosition(); err != nil {
+				m.message = err.Error()
+			} else {
+				m.message = "FEN saved to " + positionsFile + "."
+			}
+			return m, nil
+		case "ctrl+d":
+			m.handleOfferDraw()
+			return m, nil
+		case "ctrl+x":
+			m.handleResign()
+			return m, nil
+		case "ctrl+e":
+			if err := m.saveGame(); err != nil {
+				m.message = err.Error()
+			} else {
+				m.message = "Game saved to " + gamesFile + "."
+			}
+			return m, nil
+		}
+
+		return m.handleBoardKey(msg)
+
 	// Handle mouse input
 	case tea.MouseClickMsg:
 		return m.handleMouseClick(msg)
@@ -168,11 +327,31 @@ func (m *ChessModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Handle the setup screen
+	if !m.hasSelected {
+		for row := setupMode; row <= setupStart; row++ {
+			if !zone.Get(fmt.Sprintf("setup_%d", row)).InBounds(msg) {
+				continue
+			}
+			if row == setupStart {
+				return m.restart()
+			}
+			m.setupRow = row
+			m.changeSetting(row, 1)
+		}
+		return m, nil
+	}
+
+	// The board is read only while replaying a game
+	if m.replay != nil {
+		return m, nil
+	}
+
 	// Handle game over UI
 	if m.gameOver {
 		switch {
 		case zone.Get("reset").InBounds(msg):
-			return InitChessModel(), nil
+			return m.restart()
 		case zone.Get("exit").InBounds(msg):
 			return m, func() tea.Msg { return "home" }
 		default:
@@ -182,9 +361,6 @@ func (m *ChessModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 	// Check if a piece was clicked in the pawn promotion UI
 	if m.pawnPromotionTarget != nil {
-		x, y := m.pawnPromotionTarget.X, m.pawnPromotionTarget.Y
-		color := m.turn * -1
-
 		// Map each zone label to its corresponding piece value
 		promotionOptions := map[string]int8{
 			"knight": Knight,
@@ -196,61 +372,102 @@ func (m *ChessModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		// Check which promotion option was clicked
 		for label, pieceValue := range promotionOptions {
 			if zone.Get(label).InBounds(msg) {
-				m.board[y][x] = t.Piece{Color: color, Value: pieceValue}
-				m.pawnPromotionTarget = nil
-				return m, nil
+				m.handlePromotion(pieceValue)
+				return m, m.startComputerTurn()
 			}
 		}
 
 		return m, nil
 	}
 
-	// Check if a piece was clicked
+	// Check if a square was clicked
 	for y := range m.board {
 		for x := range m.board[y] {
 			// Each square is labeled by its index
 			label := fmt.Sprint(y*len(m.board) + x)
-			if !zone.Get(label).InBounds(msg) {
-				continue
+			if zone.Get(label).InBounds(msg) {
+				m.cursor = pos(x, y)
+				return m.handleSquare(pos(x, y))
 			}
+		}
+	}
 
-			piece := m.board[y][x]
-			clicked := pos(x, y)
-
-			// If a piece is already selected
-			if m.selected.X != -1 && m.selected.Y != -1 {
-				// Move if clicked on a valid move position
-				if slices.Contains(m.validMoves, clicked) {
-					m.handleMovePiece(m.selected, clicked)
-					return m, nil
-				}
-
-				// Deselect if clicked the same square again
-				if m.selected.X == x && m.selected.Y == y {
-					m.selected = pos(-1, -1)
-					m.validMoves = nil
-					return m, nil
-				}
-
-				// Select a different piece
-				if piece.Color == m.turn {
-					m.selected = clicked
-					m.validMoves = nil
-					m.generateValidMoves(clicked)
-				}
+	return m, nil
+}
 
-				return m, nil
-			}
+// handleSquare selects a piece or moves the selected piece to the given square.
+func (m *ChessModel) handleSquare(clicked t.Position) (tea.Model, tea.Cmd) {
+	// Ignore the board while the computer is moving
+	if m.isComputerTurn() {
+		return m, nil
+	}
 
-			// If no piece is selected piece, select the clicked piece
-			if piece.Value != Empty && piece.Color == m.turn {
-				m.selected = clicked
-				m.validMoves = nil
-				m.generateValidMoves(clicked)
-			}
+	piece := m.board[clicked.Y][clicked.X]
+
+	// If a piece is already selected
+	if m.selected.X != -1 && m.selected.Y != -1 {
+		// Move if clicked on a valid move position
+		if slices.Contains(m.validMoves, clicked) {
+			m.handleMovePiece(m.selected, clicked)
+			return m, m.startComputerTurn()
+		}
 
+		// Deselect if clicked the same square again
+		if m.selected == clicked {
+			m.selected = pos(-1, -1)
+			m.validMoves = nil
 			return m, nil
 		}
+
+		// Select a different piece
+		if piece.Color == m.turn {
+			m.selected = clicked
+			m.validMoves = nil
+			m.generateValidMoves(clicked)
+		}
+
+		return m, nil
+	}
+
+	// If no piece is selected piece, select the clicked piece
+	if piece.Value != Empty && piece.Color == m.turn {
+		m.selected = clicked
+		m.validMoves = nil
+		m.generateValidMoves(clicked)
+	}
+
+	return m, nil
+}
+
+// handleSetupKey handles keyboard input on the setup screen.
+func (m *ChessModel) handleSetupKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "w", "k":
+		m.setupRow = m.prevSetupRow()
+	case "down", "s", "j", "tab":
+		m.setupRow = m.nextSetupRow()
+	case "left", "a", "h":
+		m.changeSetting(m.setupRow, -1)
+	case "right", "d", "l", "space":
+		m.changeSetting(m.setupRow, 1)
+	case "enter":
+		return m.restart()
+	}
+
+	return m, nil
+}
+
+// handleEngineMove applies the move chosen by the computer opponent.
+func (m *ChessModel) handleEngineMove(msg engineMoveMsg) (tea.Model, tea.Cmd) {
+	// Drop results from searches that belong to an earlier game
+	if msg.seq != m.searchSeq || !m.isComputerTurn() {
+		return m, nil
+	}
+
+	m.thinking = false
+	m.handleMovePiece(msg.move.from, msg.move.to)
+	if m.pawnPromotionTarget != nil {
+		m.handlePromotion(msg.move.promotion)
 	}
 
 	return m, nil
@@ -258,8 +475,53 @@ func (m *ChessModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 // handleMovePiece executes a move from one position to another.
 func (m *ChessModel) handleMovePiece(from, to t.Position) {
+	san := m.san(move{from: from, to: to})
+	m.pushUndo()
+	m.movePiece(from, to)
+
+	// Clear values
+	m.selected = pos(-1, -1)
+	m.validMoves = nil
+	m.message = ""
+
+	// Making a move declines any pending draw offer
+	m.drawOffer = Empty
+
+	// Wait for the promotion choice before checking the game state
+	if m.pawnPromotionTarget != nil {
+		m.pendingMove = san
+		return
+	}
+
+	m.updateGameState()
+	m.recordMove(san)
+}
+
+// handlePromotion replaces the pawn awaiting promotion with the chosen piece.
+func (m *ChessModel) handlePromotion(value int8) {
+	x, y := m.pawnPromotionTarget.X, m.pawnPromotionTarget.Y
+	m.board[y][x] = t.Piece{Color: m.turn * -1, Value: value}
+	m.pawnPromotionTarget = nil
+	m.updateGameState()
+	m.recordMove(m.pendingMove + "=" + string(pieceLetter(t.Piece{Color: White, Value: value})))
+}
+
+// movePiece updates the board, castling rights, and en passant target for a move and passes the turn.
+func (m *ChessModel) movePiece(from, to t.Position) {
 	piece := m.board[from.Y][from.X]
 
+	// Pawn moves and captures reset the fifty-move counter
+	if piece.Value == Pawn || m.board[to.Y][to.X].Value != Empty {
+		m.halfmoveClock = 0
+	} else {
+		m.halfmoveClock++
+	}
+
+	// The move number advances after Black moves
+	if piece.Color == Black {
+		m.fullmoveNumber++
+	}
+
 	// Handle En Passant capture
 	if m.enPassantTarget != nil && to == *m.enPassantTarget {
 		if piece.Value == Pawn {
@@ -308,6 +570,18 @@ func (m *ChessModel) handleMovePiece(from, to t.Position) {
 		}
 	}
 
+	// A rook captured on its home square takes its castling right with it
+	switch {
+	case to.Y == 7 && to.X == 0:
+		m.whiteCastleQueenside = false
+	case to.Y == 7 && to.X == 7:
+		m.whiteCastleKingside = false
+	case to.Y == 0 && to.X == 0:
+		m.blackCastleQueenside = false
+	case to.Y == 0 && to.X == 7:
+		m.blackCastleKingside = false
+	}
+
 	// Handle castling movement
 	if piece.Value == King {
 		switch {
@@ -339,24 +613,129 @@ func (m *ChessModel) handleMovePiece(from, to t.Position) {
 	m.board[to.Y][to.X] = piece
 	m.board[from.Y][from.X] = newEmptyPiece()
 
-	// Clear values
-	m.selected = pos(-1, -1)
-	m.validMoves = nil
 	m.turn = m.turn * -1
+}
+
+// updateGameState refreshes check status and ends the game on checkmate, stalemate, or a draw.
+func (m *ChessModel) updateGameState() {
+	m.positionHistory = append(m.positionHistory, m.hash())
 
 	// Update king check status
 	m.isWhiteKingInCheck = m.isKingInCheck(White)
 	m.isBlackKingInCheck = m.isKingInCheck(Black)
 
 	// Check game end conditions
-	if !m.hasValidMoves(m.turn) {
+	switch {
+	case !m.hasValidMoves(m.turn):
 		m.whiteWins = m.turn == Black && m.isBlackKingInCheck
 		m.blackWins = m.turn == White && m.isWhiteKingInCheck
 		m.stalemate = !m.whiteWins && !m.blackWins
+		m.endReason = "by checkmate"
+		if m.stalemate {
+			m.endReason = "by stalemate"
+		}
 		m.gameOver = true
+	case m.hasInsufficientMaterial():
+		m.endGame(Empty, "by insufficient material")
+	case m.halfmoveClock >= 100:
+		m.endGame(Empty, "by the fifty-move rule")
+	case m.isThreefoldRepetition():
+		m.endGame(Empty, "by threefold repetition")
+	}
+}
+
+// restart begins a new game with the current settings.
+func (m *ChessModel) restart() (tea.Model, tea.Cmd) {
+	next := newGame(m.renderer, m.vsComputer, m.playerColor, m.strength)
+	next.searchSeq = m.searchSeq + 1
+	next.showCursor = m.showCursor
+
+	// Games started from a FEN restart from that position
+	if m.startFEN != "" {
+		next.loadFEN(m.startFEN)
+		next.startFEN = m.startFEN
+	}
+
+	return next, next.startComputerTurn()
+}
+
+// isComputerTurn reports whether the computer opponent is to move.
+func (m *ChessModel) isComputerTurn() bool {
+	return m.vsComputer && !m.gameOver && m.pawnPromotionTarget == nil && m.turn != m.playerColor
+}
+
+// startComputerTurn returns a command that searches for the engine's move off the UI goroutine.
+func (m *ChessModel) startComputerTurn() tea.Cmd {
+	if !m.isComputerTurn() {
+		return nil
+	}
+
+	m.thinking = true
+	seq := m.searchSeq
+	position := m.clone()
+
+	// The search only needs the board, so skip copying the history at every node
+	position.moveHistory = nil
+	position.positionHistory = nil
+	position.undoStack = nil
+	position.redoStack = nil
+	position.whiteCapturedPieces = nil
+	position.blackCapturedPieces = nil
+	strength := Strengths[m.strength]
+
+	return func() tea.Msg {
+		return engineMoveMsg{seq: seq, move: bestMove(position, strength)}
 	}
 }
 
+// changeSetting cycles the value of a setup row in the given direction.
+func (m *ChessModel) changeSetting(row, delta int) {
+	switch row {
+	case setupMode:
+		m.vsComputer = !m.vsComputer
+	case setupSide:
+		m.playerColor *= -1
+	case setupStrength:
+		m.strength = min(max(m.strength+delta, 0), len(Strengths)-1)
+	}
+}
+
+// nextSetupRow moves down the setup screen, skipping computer options in two player mode.
+func (m *ChessModel) nextSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupMode {
+		return setupStart
+	}
+	return min(m.setupRow+1, setupStart)
+}
+
+// prevSetupRow moves up the setup screen, skipping computer options in two player mode.
+func (m *ChessModel) prevSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupStart {
+		return setupMode
+	}
+	return max(m.setupRow-1, setupMode)
+}
+
+// clone returns a deep copy of the game state.
+func (m *ChessModel) clone() *ChessModel {
+	c := *m
+
+	c.board = make([][]t.Piece, len(m.board))
+	for y := range m.board {
+		c.board[y] = slices.Clone(m.board[y])
+	}
+
+	c.validMoves = slices.Clone(m.validMoves)
+	c.whiteCapturedPieces = slices.Clone(m.whiteCapturedPieces)
+	c.blackCapturedPieces = slices.Clone(m.blackCapturedPieces)
+	c.moveHistory = slices.Clone(m.moveHistory)
+	c.positionHistory = slices.Clone(m.positionHistory)
+	c.undoStack = slices.Clone(m.undoStack)
+	c.redoStack = slices.Clone(m.redoStack)
+
+	return &c
+}
+
 // addTakenPiece adds a piece to the appropriate captured piece list.
 func (m *ChessModel) addTakenPiece(piece t.Piece) {
 	if piece.Value != Empty {
@@ -0,0 +1,214 @@
+package chess
+
+import (
+	"math/rand/v2"
+	"time"
+
+	t "ascii-arcade/pkg/chess/types"
+)
+
+// Random keys for Zobrist hashing, generated from a fixed seed so hashes are stable.
+var (
+	pieceKeys    [2][King + 1][8][8]uint64
+	castlingKeys [4]uint64
+	enPassantKey [8]uint64
+	blackToMove  uint64
+)
+
+func init() {
+	r := rand.New(rand.NewPCG(0x636865, 0x7373))
+
+	for color := range pieceKeys {
+		for value := range pieceKeys[color] {
+			for y := range 8 {
+				for x := range 8 {
+					pieceKeys[color][value][y][x] = r.Uint64()
+				}
+			}
+		}
+	}
+	for i := range castlingKeys {
+		castlingKeys[i] = r.Uint64()
+	}
+	for i := range enPassantKey {
+		enPassantKey[i] = r.Uint64()
+	}
+	blackToMove = r.Uint64()
+}
+
+// hash returns the Zobrist hash of the position, used to detect repetitions.
+func (m *ChessModel) hash() uint64 {
+	var h uint64
+
+	for y := range 8 {
+		for x := range 8 {
+			p := m.board[y][x]
+			if p.Value == Empty {
+				continue
+			}
+
+			side := 0
+			if p.Color == Black {
+				side = 1
+			}
+			h ^= pieceKeys[side][p.Value][y][x]
+		}
+	}
+
+	for i, allowed := range []bool{
+		m.whiteCastleKingside,
+		m.whiteCastleQueenside,
+		m.blackCastleKingside,
+		m.blackCastleQueenside,
+	} {
+		if allowed {
+			h ^= castlingKeys[i]
+		}
+	}
+
+	// The en passant square only matters when a pawn could capture onto it
+	if m.enPassantTarget != nil && m.canCaptureEnPassant() {
+		h ^= enPassantKey[m.enPassantTarget.X]
+	}
+
+	if m.turn == Black {
+		h ^= blackToMove
+	}
+
+	return h
+}
+
+// canCaptureEnPassant reports whether a pawn of the side to move stands next to the en passant target.
+func (m *ChessModel) canCaptureEnPassant() bool {
+	target := *m.enPassantTarget
+	y := target.Y - int(m.turn)
+
+	for _, dx := range []int{-1, 1} {
+		x := target.X + dx
+		if inBounds(x, y) && m.board[y][x] == (t.Piece{Color: m.turn, Value: Pawn}) {
+			return true
+		}
+	}
+	return false
+}
+
+// isThreefoldRepetition reports whether the current position has occurred three times.
+func (m *ChessModel) isThreefoldRepetition() bool {
+	if len(m.positionHistory) == 0 {
+		return false
+	}
+
+	current := m.positionHistory[len(m.positionHistory)-1]
+	count := 0
+	for _, h := range m.positionHistory {
+		if h == current {
+			count++
+		}
+	}
+	return count >= 3
+}
+
+// hasInsufficientMaterial reports whether neither side can possibly deliver checkmate.
+func (m *ChessModel) hasInsufficientMaterial() bool {
+	var minors []t.Position
+	for y := range 8 {
+		for x := range 8 {
+			switch m.board[y][x].Value {
+			case Pawn, Rook, Queen:
+				return false
+			case Knight, Bishop:
+				minors = append(minors, pos(x, y))
+			}
+		}
+	}
+
+	// A lone king, or a king with a single minor piece, cannot mate
+	if len(minors) <= 1 {
+		return true
+	}
+
+	// Bishops that all travel on the same color squares cannot mate either
+	for _, p := range minors {
+		if m.board[p.Y][p.X].Value != Bishop || (p.X+p.Y)%2 != (minors[0].X+minors[0].Y)%2 {
+			return false
+		}
+	}
+	return true
+}
+
+// handleOfferDraw offers a draw on behalf of the side to move.
+func (m *ChessModel) handleOfferDraw() {
+	if m.gameOver || m.pawnPromotionTarget != nil || m.isComputerTurn() {
+		return
+	}
+
+	// The computer accepts when its position is no better than equal
+	if m.vsComputer {
+		if m.engineAcceptsDraw() {
+			m.endGame(Empty, "by agreement")
+		} else {
+			m.message = "The computer declines the draw."
+		}
+		return
+	}
+
+	m.drawOffer = m.turn
+	m.message = colorName(m.turn) + " offers a draw. Accept? (y / n)"
+}
+
+// handleDrawResponse accepts or declines a pending draw offer.
+func (m *ChessModel) handleDrawResponse(accept bool) {
+	if accept {
+		m.endGame(Empty, "by agreement")
+		return
+	}
+
+	m.message = colorName(m.drawOffer*-1) + " declines the draw."
+	m.drawOffer = Empty
+}
+
+// handleResign ends the game in favor of the opponent of the resigning player.
+func (m *ChessModel) handleResign() {
+	if m.gameOver {
+		return
+	}
+
+	// Against the computer it is always the player who resigns
+	loser := m.turn
+	if m.vsComputer {
+		loser = m.playerColor
+	}
+
+	m.endGame(loser*-1, "by resignation")
+}
+
+// endGame finishes the game with the given winner, or a draw when winner is Empty.
+func (m *ChessModel) endGame(winner int8, reason string) {
+	m.whiteWins = winner == White
+	m.blackWins = winner == Black
+	m.endReason = reason
+	m.gameOver = true
+	m.drawOffer = Empty
+	m.thinking = false
+	m.searchSeq++
+	m.selected = pos(-1, -1)
+	m.validMoves = nil
+}
+
+// engineAcceptsDraw runs a short search and reports whether the computer is not ahead.
+func (m *ChessModel) engineAcceptsDraw() bool {
+	e := engine{deadline: time.Now().Add(Strengths[0].MoveTime)}
+
+	position := m.clone()
+	position.moveHistory = nil
+	position.positionHistory = nil
+	position.undoStack = nil
+	position.redoStack = nil
+	score := e.negamax(position, 2, 0, -infinity, infinity)
+
+	// Scores are from the point of view of the side to move
+	if m.turn == m.playerColor {
+		score = -score
+	}
+	return score <= 0
+}
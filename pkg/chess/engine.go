@@ -0,0 +1,358 @@
+package chess
+
+import (
+	"slices"
+	"time"
+
+	t "ascii-arcade/pkg/chess/types"
+)
+
+const (
+	mateScore     = 100_000
+	infinity      = 1_000_000
+	maxQuiesce    = 8
+	checkInterval = 1024
+)
+
+// Strength describes how deep and how long the engine searches.
+type Strength struct {
+	Name     string
+	Depth    int
+	MoveTime time.Duration
+}
+
+// Strengths lists the engine levels offered on the setup screen.
+var Strengths = []Strength{
+	{Name: "Beginner", Depth: 1, MoveTime: 250 * time.Millisecond},
+	{Name: "Casual", Depth: 2, MoveTime: 500 * time.Millisecond},
+	{Name: "Club", Depth: 3, MoveTime: time.Second},
+	{Name: "Expert", Depth: 4, MoveTime: 2 * time.Second},
+	{Name: "Master", Depth: 6, MoveTime: 4 * time.Second},
+}
+
+const defaultStrength = 2
+
+// pieceValues holds the material value of each piece in centipawns.
+var pieceValues = [...]int{
+	Pawn:   100,
+	Rook:   500,
+	Knight: 320,
+	Bishop: 330,
+	Queen:  900,
+	King:   20000,
+}
+
+// Piece-square tables from White's point of view, with row 0 being the eighth rank.
+var pieceSquareTables = [...][8][8]int{
+	Pawn: {
+		{0, 0, 0, 0, 0, 0, 0, 0},
+		{50, 50, 50, 50, 50, 50, 50, 50},
+		{10, 10, 20, 30, 30, 20, 10, 10},
+		{5, 5, 10, 25, 25, 10, 5, 5},
+		{0, 0, 0, 20, 20, 0, 0, 0},
+		{5, -5, -10, 0, 0, -10, -5, 5},
+		{5, 10, 10, -20, -20, 10, 10, 5},
+		{0, 0, 0, 0, 0, 0, 0, 0},
+	},
+	Knight: {
+		{-50, -40, -30, -30, -30, -30, -40, -50},
+		{-40, -20, 0, 0, 0, 0, -20, -40},
+		{-30, 0, 10, 15, 15, 10, 0, -30},
+		{-30, 5, 15, 20, 20, 15, 5, -30},
+		{-30, 0, 15, 20, 20, 15, 0, -30},
+		{-30, 5, 10, 15, 15, 10, 5, -30},
+		{-40, -20, 0, 5, 5, 0, -20, -40},
+		{-50, -40, -30, -30, -30, -30, -40, -50},
+	},
+	Bishop: {
+		{-20, -10, -10, -10, -10, -10, -10, -20},
+		{-10, 0, 0, 0, 0, 0, 0, -10},
+		{-10, 0, 5, 10, 10, 5, 0, -10},
+		{-10, 5, 5, 10, 10, 5, 5, -10},
+		{-10, 0, 10, 10, 10, 10, 0, -10},
+		{-10, 10, 10, 10, 10, 10, 10, -10},
+		{-10, 5, 0, 0, 0, 0, 5, -10},
+		{-20, -10, -10, -10, -10, -10, -10, -20},
+	},
+	Rook: {
+		{0, 0, 0, 0, 0, 0, 0, 0},
+		{5, 10, 10, 10, 10, 10, 10, 5},
+		{-5, 0, 0, 0, 0, 0, 0, -5},
+		{-5, 0, 0, 0, 0, 0, 0, -5},
+		{-5, 0, 0, 0, 0, 0, 0, -5},
+		{-5, 0, 0, 0, 0, 0, 0, -5},
+		{-5, 0, 0, 0, 0, 0, 0, -5},
+		{0, 0, 0, 5, 5, 0, 0, 0},
+	},
+	Queen: {
+		{-20, -10, -10, -5, -5, -10, -10, -20},
+		{-10, 0, 0, 0, 0, 0, 0, -10},
+		{-10, 0, 5, 5, 5, 5, 0, -10},
+		{-5, 0, 5, 5, 5, 5, 0, -5},
+		{0, 0, 5, 5, 5, 5, 0, -5},
+		{-10, 5, 5, 5, 5, 5, 0, -10},
+		{-10, 0, 5, 0, 0, 0, 0, -10},
+		{-20, -10, -10, -5, -5, -10, -10, -20},
+	},
+	King: {
+		{-30, -40, -40, -50, -50, -40, -40, -30},
+		{-30, -40, -40, -50, -50, -40, -40, -30},
+		{-30, -40, -40, -50, -50, -40, -40, -30},
+		{-30, -40, -40, -50, -50, -40, -40, -30},
+		{-20, -30, -30, -40, -40, -30, -30, -20},
+		{-10, -20, -20, -20, -20, -20, -20, -10},
+		{20, 20, 0, 0, 0, 0, 20, 20},
+		{20, 30, 10, 0, 0, 10, 30, 20},
+	},
+}
+
+// move is a single engine move, with the piece to promote to when a pawn reaches the last rank.
+type move struct {
+	from      t.Position
+	to        t.Position
+	promotion int8
+}
+
+// engineMoveMsg carries the move chosen by the computer opponent.
+type engineMoveMsg struct {
+	seq  int
+	move move
+}
+
+// engine holds the state of a single search.
+type engine struct {
+	deadline time.Time
+	nodes    int
+	canStop  bool
+	stopped  bool
+}
+
+// bestMove searches the position with iterative deepening and returns the best move found in time.
+func bestMove(m *ChessModel, strength Strength) move {
+	e := engine{deadline: time.Now().Add(strength.MoveTime)}
+
+	moves := m.legalMoves()
+	m.orderMoves(moves)
+	best := moves[0]
+
+	for depth := 1; depth <= strength.Depth; depth++ {
+		// Search the best move from the previous iteration first
+		if i := slices.Index(moves, best); i > 0 {
+			moves = slices.Insert(slices.Delete(moves, i, i+1), 0, best)
+		}
+
+		bestScore, alpha := -infinity, -infinity
+		var iterationBest move
+		for _, mv := range moves {
+			child := m.clone()
+			child.makeMove(mv)
+
+			score := -e.negamax(child, depth-1, 1, -infinity, -alpha)
+			if e.stopped {
+				break
+			}
+
+			if score > bestScore {
+				bestScore, iterationBest = score, mv
+			}
+			alpha = max(alpha, score)
+		}
+
+		// Only trust fully searched iterations
+		if e.stopped {
+			break
+		}
+		best = iterationBest
+		e.canStop = true
+
+		// Stop early once a forced mate has been found
+		if bestScore >= mateScore-strength.Depth {
+			break
+		}
+	}
+
+	return best
+}
+
+// negamax scores a position from the point of view of the side to move.
+func (e *engine) negamax(m *ChessModel, depth, ply, alpha, beta int) int {
+	if e.timeUp() {
+		return 0
+	}
+
+	if depth <= 0 {
+		return e.quiesce(m, 0, alpha, beta)
+	}
+
+	moves := m.legalMoves()
+
+	// Checkmate or stalemate, preferring quicker mates
+	if len(moves) == 0 {
+		if m.isKingInCheck(m.turn) {
+			return -mateScore + ply
+		}
+		return 0
+	}
+
+	m.orderMoves(moves)
+	for _, mv := range moves {
+		child := m.clone()
+		child.makeMove(mv)
+
+		score := -e.negamax(child, depth-1, ply+1, -beta, -alpha)
+		if e.stopped {
+			return 0
+		}
+
+		if score >= beta {
+			return beta
+		}
+		alpha = max(alpha, score)
+	}
+
+	return alpha
+}
+
+// quiesce extends the search through captures so positions are only evaluated when quiet.
+func (e *engine) quiesce(m *ChessModel, depth, alpha, beta int) int {
+	if e.timeUp() {
+		return 0
+	}
+
+	standPat := m.evaluate()
+	if standPat >= beta || depth >= maxQuiesce {
+		return standPat
+	}
+	alpha = max(alpha, standPat)
+
+	moves := m.captureMoves()
+	m.orderMoves(moves)
+	for _, mv := range moves {
+		child := m.clone()
+		child.makeMove(mv)
+
+		score := -e.quiesce(child, depth+1, -beta, -alpha)
+		if e.stopped {
+			return 0
+		}
+
+		if score >= beta {
+			return beta
+		}
+		alpha = max(alpha, score)
+	}
+
+	return alpha
+}
+
+// timeUp checks the clock every few nodes and stops the search once the deadline passes.
+func (e *engine) timeUp() bool {
+	e.nodes++
+	if e.canStop && e.nodes%checkInterval == 0 && time.Now().After(e.deadline) {
+		e.stopped = true
+	}
+	return e.stopped
+}
+
+// legalMoves returns every legal move for the side to move.
+func (m *ChessModel) legalMoves() []move {
+	var moves []move
+
+	for y := range 8 {
+		for x := range 8 {
+			p := m.board[y][x]
+			if p.Color != m.turn || p.Value == Empty {
+				continue
+			}
+
+			m.validMoves = nil
+			m.generateValidMoves(pos(x, y))
+
+			for _, to := range m.validMoves {
+				// Pawns reaching the last rank can promote to any piece
+				if p.Value == Pawn && (to.Y == 0 || to.Y == 7) {
+					for _, value := range []int8{Queen, Knight, Rook, Bishop} {
+						moves = append(moves, move{from: pos(x, y), to: to, promotion: value})
+					}
+					continue
+				}
+
+				moves = append(moves, move{from: pos(x, y), to: to})
+			}
+		}
+	}
+
+	m.validMoves = nil
+	return moves
+}
+
+// captureMoves returns the legal captures and promotions for the side to move.
+func (m *ChessModel) captureMoves() []move {
+	moves := m.legalMoves()
+	return slices.DeleteFunc(moves, func(mv move) bool {
+		return !m.isCapture(mv) && mv.promotion == Empty
+	})
+}
+
+// isCapture reports whether a move takes a piece, including en passant.
+func (m *ChessModel) isCapture(mv move) bool {
+	if m.board[mv.to.Y][mv.to.X].Value != Empty {
+		return true
+	}
+	return m.board[mv.from.Y][mv.from.X].Value == Pawn && mv.from.X != mv.to.X
+}
+
+// orderMoves sorts moves so that promotions and the most valuable captures are searched first.
+func (m *ChessModel) orderMoves(moves []move) {
+	score := func(mv move) int {
+		s := pieceValues[mv.promotion]
+		if m.isCapture(mv) {
+			// Most valuable victim, least valuable attacker
+			victim := max(pieceValues[m.board[mv.to.Y][mv.to.X].Value], pieceValues[Pawn])
+			s += victim*10 - pieceValues[m.board[mv.from.Y][mv.from.X].Value]/10
+		}
+		return s
+	}
+
+	slices.SortStableFunc(moves, func(a, b move) int {
+		return score(b) - score(a)
+	})
+}
+
+// makeMove plays a move on the board without updating the check or game over state.
+func (m *ChessModel) makeMove(mv move) {
+	m.movePiece(mv.from, mv.to)
+	if m.pawnPromotionTarget != nil {
+		x, y := m.pawnPromotionTarget.X, m.pawnPromotionTarget.Y
+		m.board[y][x] = t.Piece{Color: m.turn * -1, Value: mv.promotion}
+		m.pawnPromotionTarget = nil
+	}
+}
+
+// evaluate scores material and piece placement from the point of view of the side to move.
+func (m *ChessModel) evaluate() int {
+	score := 0
+	for y := range 8 {
+		for x := range 8 {
+			p := m.board[y][x]
+			if p.Value == Empty {
+				continue
+			}
+
+			// Mirror the table vertically for Black
+			row := y
+			if p.Color == Black {
+				row = 7 - y
+			}
+
+			value := pieceValues[p.Value] + pieceSquareTables[p.Value][row][x]
+			if p.Color == m.turn {
+				score += value
+			} else {
+				score -= value
+			}
+		}
+	}
+
+	return score
+}
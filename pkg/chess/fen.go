@@ -0,0 +1,353 @@
+package chess
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	t "ascii-arcade/pkg/chess/types"
+)
+
+const (
+	StartFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+	positionsFile = "data/chess/positions.fen"
+)
+
+// fenPieces maps FEN piece letters to piece values.
+var fenPieces = map[byte]int8{
+	'p': Pawn,
+	'r': Rook,
+	'n': Knight,
+	'b': Bishop,
+	'q': Queen,
+	'k': King,
+}
+
+// startingCounts is the number of each piece a side begins the game with.
+var startingCounts = map[int8]int{
+	Pawn:   8,
+	Rook:   2,
+	Knight: 2,
+	Bishop: 2,
+	Queen:  1,
+}
+
+// InitChessModelFromFEN creates a chess model on the setup screen that starts from the given position.
+func InitChessModelFromFEN(fen string) (*ChessModel, error) {
+	m := InitChessModel()
+	if err := m.loadFEN(fen); err != nil {
+		return nil, err
+	}
+
+	m.startFEN = fen
+	return m, nil
+}
+
+// loadFEN replaces the game state with the position described by a FEN string.
+func (m *ChessModel) loadFEN(fen string) error {
+	fields := strings.Fields(fen)
+	if len(fields) != 4 && len(fields) != 6 {
+		return fmt.Errorf("invalid FEN: expected 6 fields, got %d", len(fields))
+	}
+
+	// The move clocks are optional and default to the start of the game
+	if len(fields) == 4 {
+		fields = append(fields, "0", "1")
+	}
+
+	board, err := parseFENBoard(fields[0])
+	if err != nil {
+		return err
+	}
+
+	var turn int8
+	switch fields[1] {
+	case "w":
+		turn = White
+	case "b":
+		turn = Black
+	default:
+		return fmt.Errorf("invalid side to move: %q", fields[1])
+	}
+
+	if !validCastlingField(fields[2]) {
+		return fmt.Errorf("invalid castling rights: %q", fields[2])
+	}
+
+	var enPassantTarget *t.Position
+	if fields[3] != "-" {
+		target, ok := parseSquare(fields[3])
+		if !ok || !validEnPassant(board, turn, target) {
+			return fmt.Errorf("invalid en passant square: %q", fields[3])
+		}
+		enPassantTarget = &target
+	}
+
+	halfmoveClock, err := strconv.Atoi(fields[4])
+	if err != nil || halfmoveClock < 0 {
+		return fmt.Errorf("invalid halfmove clock: %q", fields[4])
+	}
+
+	fullmoveNumber, err := strconv.Atoi(fields[5])
+	if err != nil || fullmoveNumber < 1 {
+		return fmt.Errorf("invalid fullmove number: %q", fields[5])
+	}
+
+	// Check the position on a scratch model so a rejected FEN leaves the game untouched
+	scratch := &ChessModel{board: board}
+
+	// The side that just moved cannot be left in check
+	if scratch.isKingInCheck(turn * -1) {
+		return fmt.Errorf("invalid FEN: side not to move is in check")
+	}
+
+	m.board = board
+	m.turn = turn
+
+	// Rights whose king or rook is no longer on its home square are dropped
+	m.whiteCastleKingside = strings.Contains(fields[2], "K") && m.hasCastlingPieces(White, 7)
+	m.whiteCastleQueenside = strings.Contains(fields[2], "Q") && m.hasCastlingPieces(White, 0)
+	m.blackCastleKingside = strings.Contains(fields[2], "k") && m.hasCastlingPieces(Black, 7)
+	m.blackCastleQueenside = strings.Contains(fields[2], "q") && m.hasCastlingPieces(Black, 0)
+
+	m.enPassantTarget = enPassantTarget
+	m.halfmoveClock = halfmoveClock
+	m.fullmoveNumber = fullmoveNumber
+	m.startFullmove = fullmoveNumber
+	m.startTurn = turn
+	m.moveHistory = nil
+	m.positionHistory = nil
+
+	m.whiteCapturedPieces = m.missingPieces(Black)
+	m.blackCapturedPieces = m.missingPieces(White)
+	m.selected = pos(-1, -1)
+	m.validMoves = nil
+	m.pawnPromotionTarget = nil
+	m.updateGameState()
+
+	return nil
+}
+
+// validCastlingField reports whether a castling field is "-" or letters of "KQkq" in that order without repeats.
+func validCastlingField(field string) bool {
+	if field == "-" {
+		return true
+	}
+
+	order := "KQkq"
+	for i := range len(field) {
+		next := strings.IndexByte(order, field[i])
+		if next == -1 {
+			return false
+		}
+		order = order[next+1:]
+	}
+	return field != ""
+}
+
+// validEnPassant reports whether the opponent's last move could have been a pawn advancing
+// two squares past target: the target is behind the pawn on the side to move's capture rank,
+// and both it and the square the pawn started from are empty.
+func validEnPassant(board [][]t.Piece, turn int8, target t.Position) bool {
+	// White captures onto the sixth rank and Black onto the third
+	rank, step := 2, 1
+	if turn == Black {
+		rank, step = 5, -1
+	}
+	if target.Y != rank {
+		return false
+	}
+
+	pawn := board[target.Y+step][target.X]
+	return pawn.Value == Pawn && pawn.Color == turn*-1 &&
+		board[target.Y][target.X].Value == Empty &&
+		board[target.Y-step][target.X].Value == Empty
+}
+
+// parseFENBoard decodes the piece placement field of a FEN string.
+func parseFENBoard(field string) ([][]t.Piece, error) {
+	ranks := strings.Split(field, "/")
+	if len(ranks) != 8 {
+		return nil, fmt.Errorf("invalid FEN: expected 8 ranks, got %d", len(ranks))
+	}
+
+	kings := map[int8]int{}
+	board := make([][]t.Piece, 8)
+	for y, rank := range ranks {
+		for i := range len(rank) {
+			c := rank[i]
+
+			// Digits stand for runs of empty squares
+			if c >= '1' && c <= '8' {
+				for range c - '0' {
+					board[y] = append(board[y], newEmptyPiece())
+				}
+				continue
+			}
+
+			value, ok := fenPieces[c|0x20]
+			if !ok {
+				return nil, fmt.Errorf("invalid piece: %q", c)
+			}
+
+			color := int8(Black)
+			if c >= 'A' && c <= 'Z' {
+				color = White
+			}
+
+			if value == Pawn && (y == 0 || y == 7) {
+				return nil, fmt.Errorf("invalid FEN: pawn on rank %d", 8-y)
+			}
+			if value == King {
+				kings[color]++
+			}
+
+			board[y] = append(board[y], t.Piece{Color: color, Value: value})
+		}
+
+		if len(board[y]) != 8 {
+			return nil, fmt.Errorf("invalid rank %d: %q", 8-y, rank)
+		}
+	}
+
+	if kings[White] != 1 || kings[Black] != 1 {
+		return nil, fmt.Errorf("invalid FEN: each side needs exactly one king")
+	}
+
+	return board, nil
+}
+
+// FEN encodes the current position as a FEN string.
+func (m *ChessModel) FEN() string {
+	var b strings.Builder
+
+	// Piece placement
+	for y := range 8 {
+		empty := 0
+		for x := range 8 {
+			p := m.board[y][x]
+			if p.Value == Empty {
+				empty++
+				continue
+			}
+
+			if empty > 0 {
+				b.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			b.WriteByte(pieceLetter(p))
+		}
+
+		if empty > 0 {
+			b.WriteString(strconv.Itoa(empty))
+		}
+		if y < 7 {
+			b.WriteByte('/')
+		}
+	}
+
+	// Side to move
+	if m.turn == White {
+		b.WriteString(" w ")
+	} else {
+		b.WriteString(" b ")
+	}
+
+	// Castling rights
+	castling := ""
+	for _, right := range []struct {
+		allowed bool
+		letter  string
+	}{
+		{m.whiteCastleKingside, "K"},
+		{m.whiteCastleQueenside, "Q"},
+		{m.blackCastleKingside, "k"},
+		{m.blackCastleQueenside, "q"},
+	} {
+		if right.allowed {
+			castling += right.letter
+		}
+	}
+	if castling == "" {
+		castling = "-"
+	}
+	b.WriteString(castling)
+
+	// En passant target
+	if m.enPassantTarget != nil {
+		b.WriteString(" " + squareName(*m.enPassantTarget))
+	} else {
+		b.WriteString(" -")
+	}
+
+	fmt.Fprintf(&b, " %d %d", m.halfmoveClock, m.fullmoveNumber)
+
+	return b.String()
+}
+
+// savePosition appends the current FEN to the positions file.
+func (m *ChessModel) savePosition() error {
+	if err := os.MkdirAll("data/chess", 0755); err != nil {
+		return fmt.Errorf("error creating data dir: %v", err)
+	}
+
+	f, err := os.OpenFile(positionsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening positions file: %v", err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintln(f, m.FEN()); err != nil {
+		return fmt.Errorf("error writing position: %v", err)
+	}
+	return nil
+}
+
+// missingPieces lists the pieces of a color that are no longer on the board.
+func (m *ChessModel) missingPieces(color int8) []t.Piece {
+	counts := map[int8]int{}
+	for y := range 8 {
+		for x := range 8 {
+			if p := m.board[y][x]; p.Color == color {
+				counts[p.Value]++
+			}
+		}
+	}
+
+	var missing []t.Piece
+	for _, value := range []int8{Queen, Rook, Bishop, Knight, Pawn} {
+		for range startingCounts[value] - counts[value] {
+			missing = append(missing, t.Piece{Color: color, Value: value})
+		}
+	}
+	return missing
+}
+
+// pieceLetter returns the FEN letter for a piece, uppercase for White.
+func pieceLetter(p t.Piece) byte {
+	for letter, value := range fenPieces {
+		if value != p.Value {
+			continue
+		}
+		if p.Color == White {
+			return letter - 0x20
+		}
+		return letter
+	}
+	return '?'
+}
+
+// parseSquare converts a square name such as "e4" into a board position.
+func parseSquare(s string) (t.Position, bool) {
+	if len(s) != 2 || s[0] < 'a' || s[0] > 'h' || s[1] < '1' || s[1] > '8' {
+		return t.Position{}, false
+	}
+	return pos(int(s[0]-'a'), int('8'-s[1])), true
+}
+
+// squareName converts a board position into a square name such as "e4".
+func squareName(p t.Position) string {
+	return string([]byte{byte('a' + p.X), byte('8' - p.Y)})
+}
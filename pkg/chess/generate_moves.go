@@ -180,6 +180,7 @@ func (m *ChessModel) generateCastlingMoves(king t.Position) []t.Position {
 
 	// Kingside castling
 	if ((color == White && m.whiteCastleKingside) || (color == Black && m.blackCastleKingside)) &&
+		m.isRookOn(pos(7, y), color) &&
 		m.isPathClearTo(king, pos(7, y), 1, 0) &&
 		!m.isKingInCheck(color) &&
 		!m.wouldBeInCheck(king, pos(5, y)) &&
@@ -190,6 +191,7 @@ func (m *ChessModel) generateCastlingMoves(king t.Position) []t.Position {
 
 	// Queenside castling
 	if ((color == White && m.whiteCastleQueenside) || (color == Black && m.blackCastleQueenside)) &&
+		m.isRookOn(pos(0, y), color) &&
 		m.isPathClearTo(king, pos(0, y), -1, 0) &&
 		!m.isKingInCheck(color) &&
 		!m.wouldBeInCheck(king, pos(3, y)) &&
@@ -201,6 +203,23 @@ func (m *ChessModel) generateCastlingMoves(king t.Position) []t.Position {
 	return validMoves
 }
 
+// isRookOn reports whether a rook of the given color stands on a square.
+func (m *ChessModel) isRookOn(square t.Position, color int8) bool {
+	piece := m.board[square.Y][square.X]
+	return piece.Value == Rook && piece.Color == color
+}
+
+// hasCastlingPieces reports whether the king and the rook in the given corner file are on their home squares.
+func (m *ChessModel) hasCastlingPieces(color int8, rookX int) bool {
+	y := 7
+	if color == Black {
+		y = 0
+	}
+
+	king := m.board[y][4]
+	return king.Value == King && king.Color == color && m.isRookOn(pos(rookX, y), color)
+}
+
 // hasValidMoves checks if the given color has at least one legal move.
 func (m *ChessModel) hasValidMoves(color int8) bool {
 	for y := range 8 {
@@ -24,7 +24,13 @@ strategy game.
 • Each piece moves in its own unique way.
 • Select a piece to see its valid moves highlighted.
 • Move your pieces to control the board and capture
-  your opponent’s.`
+  your opponent’s.
+• The game is drawn by stalemate, threefold repetition,
+  the fifty-move rule, or insufficient material.
+
+Play against a friend on the same keyboard or against the
+built-in engine. Pick your side and the engine's strength
+from the setup screen.`
 
 	Rendering = `There are 3 different styles of rendering:
 • Block - Pieces drawn using block characters
@@ -58,7 +64,20 @@ func (m *ChessModel) Help() string {
 	// Define keybindings specific to the game
 	gameKeybinds := []components.Keybind{
 		{Key: "click", Action: "select"},
+		{Key: "↑ ↓ ← → / hjkl", Action: "move cursor"},
+		{Key: "enter / space", Action: "select / move"},
+		{Key: "/", Action: "type a move (e2e4, Nf3)"},
+		{Key: "q / r / b / n", Action: "choose promotion"},
 		{Key: "1 / 2 / 3", Action: "change renderer"},
+		{Key: "u / ctrl+z", Action: "undo move"},
+		{Key: "U / ctrl+shift+z", Action: "redo move"},
+		{Key: "ctrl+n", Action: "game settings"},
+		{Key: "ctrl+d", Action: "offer draw"},
+		{Key: "ctrl+x", Action: "resign"},
+		{Key: "ctrl+y", Action: "copy FEN"},
+		{Key: "ctrl+f", Action: "save FEN to file"},
+		{Key: "ctrl+e", Action: "save game as PGN"},
+		{Key: "← / →", Action: "step through replay"},
 	}
 
 	keybinds := components.JoinKeybinds(
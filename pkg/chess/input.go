@@ -0,0 +1,146 @@
+package chess
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	t "ascii-arcade/pkg/chess/types"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// coordinatePattern matches moves written as squares, such as "e2e4" or "e7e8q".
+var coordinatePattern = regexp.MustCompile(`^([a-h][1-8])-?([a-h][1-8])([qrbnQRBN]?)$`)
+
+// promotionKeys maps the keys accepted on the promotion screen to piece values.
+var promotionKeys = map[string]int8{
+	"q": Queen,
+	"r": Rook,
+	"b": Bishop,
+	"n": Knight,
+}
+
+// handleBoardKey handles keyboard play: moving the cursor, selecting squares, and promotion.
+func (m *ChessModel) handleBoardKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if m.gameOver {
+		return m, nil
+	}
+
+	// Choose the promoted piece by its letter
+	if m.pawnPromotionTarget != nil {
+		if value, ok := promotionKeys[msg.String()]; ok {
+			m.handlePromotion(value)
+			return m, m.startComputerTurn()
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		m.moveCursor(0, -1)
+	case "down", "j":
+		m.moveCursor(0, 1)
+	case "left", "h":
+		m.moveCursor(-1, 0)
+	case "right", "l":
+		m.moveCursor(1, 0)
+	case "enter", "space":
+		// The first key press only reveals the cursor
+		if !m.showCursor {
+			m.showCursor = true
+			return m, nil
+		}
+		return m.handleSquare(m.cursor)
+	case "/":
+		if !m.isComputerTurn() {
+			m.typing = true
+			m.input = ""
+			m.message = ""
+		}
+	}
+
+	return m, nil
+}
+
+// moveCursor moves the board cursor, showing it at its last position first.
+func (m *ChessModel) moveCursor(dx, dy int) {
+	if !m.showCursor {
+		m.showCursor = true
+		return
+	}
+
+	m.cursor.X = min(max(m.cursor.X+dx, 0), len(m.board)-1)
+	m.cursor.Y = min(max(m.cursor.Y+dy, 0), len(m.board)-1)
+}
+
+// handleInputKey edits and submits a typed move.
+func (m *ChessModel) handleInputKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.typing = false
+		m.input = ""
+	case "backspace":
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+	case "enter":
+		m.typing = false
+		mv, err := m.parseMoveInput(m.input)
+		if err != nil {
+			m.message = err.Error()
+			return m, nil
+		}
+
+		m.handleMovePiece(mv.from, mv.to)
+		if m.pawnPromotionTarget != nil {
+			m.handlePromotion(mv.promotion)
+		}
+		return m, m.startComputerTurn()
+	default:
+		if text := msg.String(); len(text) == 1 && len(m.input) < 10 {
+			m.input += text
+		}
+	}
+
+	return m, nil
+}
+
+// parseMoveInput finds the legal move for typed input in coordinate or algebraic notation.
+func (m *ChessModel) parseMoveInput(text string) (move, error) {
+	text = strings.TrimSpace(text)
+
+	match := coordinatePattern.FindStringSubmatch(text)
+	if match == nil {
+		return m.parseSAN(text)
+	}
+
+	from, _ := parseSquare(match[1])
+	to, _ := parseSquare(match[2])
+
+	// Promote to a queen unless another piece is named
+	promotion := int8(Empty)
+	if m.board[from.Y][from.X].Value == Pawn && (to.Y == 0 || to.Y == 7) {
+		promotion = Queen
+		if match[3] != "" {
+			promotion = promotionKeys[strings.ToLower(match[3])]
+		}
+	}
+
+	want := move{from: from, to: to, promotion: promotion}
+	for _, mv := range m.clone().legalMoves() {
+		if mv == want {
+			return mv, nil
+		}
+	}
+
+	return move{}, fmt.Errorf("illegal move: %q", text)
+}
+
+// defaultCursor returns the starting cursor square, in front of the king of the side to move.
+func defaultCursor(color int8) t.Position {
+	if color == Black {
+		return pos(4, 1)
+	}
+	return pos(4, 6)
+}
@@ -0,0 +1,341 @@
+package chess
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	t "ascii-arcade/pkg/chess/types"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const gamesFile = "data/chess/games.pgn"
+
+var (
+	tagPattern    = regexp.MustCompile(`^\[(\w+)\s+"((?:[^"\\]|\\.)*)"\]$`)
+	numberPattern = regexp.MustCompile(`^\d+\.+`)
+	results       = []string{"1-0", "0-1", "1/2-1/2", "*"}
+)
+
+// replay steps through the positions of an imported game.
+type replay struct {
+	positions []*ChessModel
+	index     int
+}
+
+// LoadPGNFile opens the first game in a PGN file for replay.
+func LoadPGNFile(path string) (*ChessModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading PGN file: %v", err)
+	}
+	return InitChessModelFromPGN(string(data))
+}
+
+// InitChessModelFromPGN plays through a PGN game and opens it for replay from the start.
+func InitChessModelFromPGN(pgn string) (*ChessModel, error) {
+	tags, moves, err := parsePGN(pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	m := newGame(Block, false, White, defaultStrength)
+	if tags["SetUp"] == "1" || tags["FEN"] != "" {
+		if err := m.loadFEN(tags["FEN"]); err != nil {
+			return nil, err
+		}
+		m.startFEN = tags["FEN"]
+	}
+
+	// Record every position so the game can be stepped through in both directions
+	positions := []*ChessModel{m.clone()}
+	for i, text := range moves {
+		// Rule based draws are claimed over the board, so recorded games may play on
+		if m.gameOver && !m.whiteWins && !m.blackWins && !m.stalemate {
+			m.gameOver = false
+			m.endReason = ""
+		}
+
+		if m.gameOver {
+			return nil, fmt.Errorf("move %d played after the game ended: %q", i+1, text)
+		}
+
+		mv, err := m.parseSAN(text)
+		if err != nil {
+			return nil, err
+		}
+
+		m.handleMovePiece(mv.from, mv.to)
+		if m.pawnPromotionTarget != nil {
+			m.handlePromotion(mv.promotion)
+		}
+		positions = append(positions, m.clone())
+	}
+
+	start := positions[0].clone()
+	start.replay = &replay{positions: positions}
+	return start, nil
+}
+
+// parsePGN splits the first game of a PGN file into its tags and moves.
+func parsePGN(pgn string) (map[string]string, []string, error) {
+	tags := map[string]string{}
+
+	var movetext strings.Builder
+	for line := range strings.Lines(pgn) {
+		line = strings.TrimSpace(line)
+
+		// Escaped lines and empty lines carry no moves
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			// A tag after the movetext starts the next game
+			if movetext.Len() > 0 {
+				break
+			}
+
+			match := tagPattern.FindStringSubmatch(line)
+			if match == nil {
+				return nil, nil, fmt.Errorf("invalid PGN tag: %q", line)
+			}
+			tags[match[1]] = strings.ReplaceAll(match[2], `\"`, `"`)
+			continue
+		}
+
+		movetext.WriteString(line + "\n")
+	}
+
+	moves, err := parseMovetext(movetext.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, moves, nil
+}
+
+// parseMovetext extracts the main line moves, skipping comments, variations, and annotations.
+func parseMovetext(text string) ([]string, error) {
+	var moves []string
+	var token strings.Builder
+	depth := 0
+
+	flush := func() {
+		move := numberPattern.ReplaceAllString(token.String(), "")
+		token.Reset()
+
+		if move == "" || strings.HasPrefix(move, "$") {
+			return
+		}
+		for _, result := range results {
+			if move == result {
+				return
+			}
+		}
+		moves = append(moves, move)
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		// Brace comments run until the closing brace
+		case c == '{':
+			end := strings.IndexByte(text[i:], '}')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated PGN comment")
+			}
+			flush()
+			i += end
+
+		// Line comments run until the end of the line
+		case c == ';':
+			end := strings.IndexByte(text[i:], '\n')
+			if end == -1 {
+				end = len(text) - i
+			}
+			flush()
+			i += end
+
+		// Variations can be nested and are skipped entirely
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("unbalanced PGN variation")
+			}
+			depth--
+
+		case depth > 0:
+			continue
+
+		case c == ' ' || c == '\n' || c == '\t' || c == '\r':
+			flush()
+
+		default:
+			token.WriteByte(c)
+		}
+	}
+	flush()
+
+	return moves, nil
+}
+
+// PGN encodes the game played so far, including its tags.
+func (m *ChessModel) PGN() string {
+	white, black := "Player 1", "Player 2"
+	if m.vsComputer {
+		computer := fmt.Sprintf("ascii-arcade (%s)", Strengths[m.strength].Name)
+		white, black = "Player", computer
+		if m.playerColor == Black {
+			white, black = computer, "Player"
+		}
+	}
+
+	var b strings.Builder
+	writeTag := func(name, value string) {
+		fmt.Fprintf(&b, "[%s \"%s\"]\n", name, strings.ReplaceAll(value, `"`, `\"`))
+	}
+
+	writeTag("Event", "Casual Game")
+	writeTag("Site", "ascii-arcade")
+	writeTag("Date", time.Now().Format("2006.01.02"))
+	writeTag("Round", "-")
+	writeTag("White", white)
+	writeTag("Black", black)
+	writeTag("Result", m.result())
+	if m.startFEN != "" {
+		writeTag("SetUp", "1")
+		writeTag("FEN", m.startFEN)
+	}
+	b.WriteString("\n")
+
+	// Wrap the movetext at 80 columns
+	line := ""
+	for _, token := range append(m.movetextTokens(), m.result()) {
+		if line != "" && len(line)+1+len(token) > 80 {
+			b.WriteString(line + "\n")
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += token
+	}
+	b.WriteString(line + "\n")
+
+	return b.String()
+}
+
+// movetextTokens returns the moves of the game with move numbers.
+func (m *ChessModel) movetextTokens() []string {
+	var tokens []string
+	for _, row := range m.moveRows(-1) {
+		// Keep move numbers on the same line as their move
+		switch {
+		case row.Moves[0] == "":
+			tokens = append(tokens, fmt.Sprintf("%d... %s", row.Number, row.Moves[1]))
+		case row.Moves[1] == "":
+			tokens = append(tokens, fmt.Sprintf("%d. %s", row.Number, row.Moves[0]))
+		default:
+			tokens = append(tokens, fmt.Sprintf("%d. %s", row.Number, row.Moves[0]), row.Moves[1])
+		}
+	}
+	return tokens
+}
+
+// moveRows pairs the move history into numbered rows, marking the move at index current.
+func (m *ChessModel) moveRows(current int) []t.MoveRow {
+	var rows []t.MoveRow
+
+	number := m.startFullmove
+	side := 0
+	if m.startTurn == Black {
+		side = 1
+	}
+
+	for i, san := range m.moveHistory {
+		if side == 0 || len(rows) == 0 {
+			rows = append(rows, t.MoveRow{Number: number, Current: -1})
+		}
+
+		row := &rows[len(rows)-1]
+		row.Moves[side] = san
+		if i == current {
+			row.Current = side
+		}
+
+		// Move numbers advance after Black's move
+		if side == 1 {
+			number++
+		}
+		side = 1 - side
+	}
+
+	return rows
+}
+
+// result returns the PGN result of the game.
+func (m *ChessModel) result() string {
+	switch {
+	case m.whiteWins:
+		return "1-0"
+	case m.blackWins:
+		return "0-1"
+	case m.gameOver:
+		return "1/2-1/2"
+	}
+	return "*"
+}
+
+// saveGame appends the game to the PGN games file.
+func (m *ChessModel) saveGame() error {
+	if err := os.MkdirAll("data/chess", 0755); err != nil {
+		return fmt.Errorf("error creating data dir: %v", err)
+	}
+
+	f, err := os.OpenFile(gamesFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening games file: %v", err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintln(f, m.PGN()); err != nil {
+		return fmt.Errorf("error writing game: %v", err)
+	}
+	return nil
+}
+
+// handleReplayKey steps through an imported game.
+func (m *ChessModel) handleReplayKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	r := m.replay
+	last := len(r.positions) - 1
+
+	switch msg.String() {
+	case "left", "h", "a":
+		r.index = max(r.index-1, 0)
+	case "right", "l", "d":
+		r.index = min(r.index+1, last)
+	case "home", "up", "k", "w":
+		r.index = 0
+	case "end", "down", "j", "s":
+		r.index = last
+	case "enter":
+		// Continue playing from the shown position
+		next := r.positions[r.index].clone()
+		next.renderer = m.renderer
+		next.replay = nil
+		return next, nil
+	}
+
+	return m, nil
+}
+
+// replayPosition returns the position currently shown by the replay.
+func (m *ChessModel) replayPosition() *ChessModel {
+	return m.replay.positions[m.replay.index]
+}
@@ -31,6 +31,7 @@ var (
 	CSelected = colors.Blue
 	CTake     = colors.Pink
 	CCheck    = colors.Red
+	CCursor   = colors.Medium1
 
 	WhitePiece    = lipgloss.NewStyle().Foreground(CWhite).Bold(true)
 	BlackPiece    = lipgloss.NewStyle().Foreground(CBlack).Bold(true)
@@ -48,4 +49,25 @@ var (
 
 	EvenCell = EmptyCell.
 			Background(colors.Light2)
+
+	CursorCell = EmptyCell.
+			Background(CCursor)
+
+	MoveListRows = 30
+
+	MoveList = lipgloss.NewStyle().
+			MarginTop(9).
+			MarginLeft(2).
+			Width(22)
+
+	MoveListHeader = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	MoveNumber  = lipgloss.NewStyle().Foreground(colors.Medium2)
+	MoveText    = lipgloss.NewStyle().Foreground(colors.Light2)
+	CurrentMove = lipgloss.NewStyle().Foreground(colors.Dark1).Background(CSelected).Bold(true)
 )
@@ -4,6 +4,7 @@ import (
 	t "ascii-arcade/pkg/chess/types"
 	"fmt"
 	"image/color"
+	"strings"
 
 	"charm.land/lipgloss/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -58,12 +59,14 @@ func (r BlockRenderer) View() string {
 	}
 
 	// Assemble the full view
-	return lipgloss.JoinVertical(
+	board := lipgloss.JoinVertical(
 		lipgloss.Left,
 		r.viewTakenPieces(r.BlackCapturedPieces)+"\n",
 		r.viewBoard(pieces),
 		r.viewTakenPieces(r.WhiteCapturedPieces),
 	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, board, r.viewMoveList())
 }
 
 // viewBoard renders a grid of strings into a styled chessboard.
@@ -77,6 +80,11 @@ func (r BlockRenderer) viewBoard(board [][]string) string {
 			isEven := (x+y)%2 == 0
 			style := styleCell(isEven, EvenCell, OddCell)
 
+			// Highlight the keyboard cursor
+			if r.Cursor == (t.Position{X: x, Y: y}) {
+				style = CursorCell
+			}
+
 			// Use a unique zone label for mouse interactivity
 			label := fmt.Sprint(y*len(board) + x)
 			row[x] = zone.Mark(label, style.Render(content))
@@ -88,6 +96,40 @@ func (r BlockRenderer) viewBoard(board [][]string) string {
 	return lipgloss.JoinVertical(lipgloss.Left, rows...)
 }
 
+// viewMoveList renders the most recent moves, keeping the current move in view.
+func (r BlockRenderer) viewMoveList() string {
+	rows := r.MoveRows
+
+	// Scroll so the current move is the last visible row
+	end := len(rows)
+	for i, row := range rows {
+		if row.Current != -1 {
+			end = i + 1
+		}
+	}
+	start := max(end-MoveListRows, 0)
+
+	lines := []string{MoveListHeader.Render("Moves")}
+	for _, row := range rows[start:end] {
+		line := MoveNumber.Render(fmt.Sprintf("%3d. ", row.Number))
+		for side, san := range row.Moves {
+			if san == "" && side == 0 {
+				san = "..."
+			}
+
+			cell := fmt.Sprintf("%-8s", san)
+			if row.Current == side {
+				line += CurrentMove.Render(cell)
+			} else {
+				line += MoveText.Render(cell)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return MoveList.Render(strings.Join(lines, "\n"))
+}
+
 // viewTakenPieces renders the captured pieces for the given player.
 func (r BlockRenderer) viewTakenPieces(takenPieces []t.Piece) string {
 	var cells [16]string
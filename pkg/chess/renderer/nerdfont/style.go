@@ -30,6 +30,7 @@ var (
 	CSelected = colors.Blue
 	CTake     = colors.Pink
 	CCheck    = colors.Red
+	CCursor   = colors.Medium1
 
 	WhitePiece    = lipgloss.NewStyle().Foreground(CWhite).Bold(true)
 	BlackPiece    = lipgloss.NewStyle().Foreground(CBlack).Bold(true)
@@ -47,6 +48,9 @@ var (
 	EvenCell = EmptyCell.
 			Background(colors.Light2)
 
+	CursorCell = EmptyCell.
+			Background(CCursor)
+
 	MarginEven = lipgloss.NewStyle().
 			Background(colors.Dark1).
 			Foreground(colors.Light2)
@@ -60,4 +64,22 @@ var (
 
 	EndMarginOdd = lipgloss.NewStyle().
 			Foreground(colors.Dark1)
+
+	MoveListRows = 15
+
+	MoveList = lipgloss.NewStyle().
+			MarginTop(3).
+			MarginLeft(2).
+			Width(22)
+
+	MoveListHeader = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	MoveNumber  = lipgloss.NewStyle().Foreground(colors.Medium2)
+	MoveText    = lipgloss.NewStyle().Foreground(colors.Light2)
+	CurrentMove = lipgloss.NewStyle().Foreground(colors.Dark1).Background(CSelected).Bold(true)
 )
@@ -0,0 +1,124 @@
+package chess
+
+import (
+	"fmt"
+	"strings"
+
+	t "ascii-arcade/pkg/chess/types"
+)
+
+// san returns the Standard Algebraic Notation for a legal move, without a check suffix.
+func (m *ChessModel) san(mv move) string {
+	return m.sanAmong(mv, m.clone().legalMoves())
+}
+
+// sanAmong returns the notation for a move, using the other legal moves to disambiguate.
+func (m *ChessModel) sanAmong(mv move, legal []move) string {
+	piece := m.board[mv.from.Y][mv.from.X]
+
+	// Castling is written by the side it is played on
+	if piece.Value == King && abs(mv.to.X-mv.from.X) == 2 {
+		if mv.to.X == 6 {
+			return "O-O"
+		}
+		return "O-O-O"
+	}
+
+	var b strings.Builder
+	capture := m.isCapture(mv)
+
+	if piece.Value == Pawn {
+		// Pawn captures name the file the pawn left
+		if capture {
+			b.WriteByte(byte('a' + mv.from.X))
+			b.WriteByte('x')
+		}
+		b.WriteString(squareName(mv.to))
+
+		if mv.promotion != Empty {
+			b.WriteByte('=')
+			b.WriteByte(pieceLetter(t.Piece{Color: White, Value: mv.promotion}))
+		}
+		return b.String()
+	}
+
+	b.WriteByte(pieceLetter(t.Piece{Color: White, Value: piece.Value}))
+
+	// Disambiguate between identical pieces that can reach the same square
+	ambiguous, sameFile, sameRank := false, false, false
+	for _, other := range legal {
+		if other.to != mv.to || other.from == mv.from {
+			continue
+		}
+		if m.board[other.from.Y][other.from.X].Value != piece.Value {
+			continue
+		}
+
+		ambiguous = true
+		sameFile = sameFile || other.from.X == mv.from.X
+		sameRank = sameRank || other.from.Y == mv.from.Y
+	}
+
+	switch {
+	case ambiguous && !sameFile:
+		b.WriteByte(byte('a' + mv.from.X))
+	case ambiguous && !sameRank:
+		b.WriteByte(byte('8' - mv.from.Y))
+	case ambiguous:
+		b.WriteString(squareName(mv.from))
+	}
+
+	if capture {
+		b.WriteByte('x')
+	}
+	b.WriteString(squareName(mv.to))
+
+	return b.String()
+}
+
+// recordMove adds a completed move to the history with its check or mate suffix.
+func (m *ChessModel) recordMove(san string) {
+	switch {
+	case m.whiteWins || m.blackWins:
+		san += "#"
+	case m.isWhiteKingInCheck || m.isBlackKingInCheck:
+		san += "+"
+	}
+
+	m.moveHistory = append(m.moveHistory, san)
+}
+
+// parseSAN finds the legal move matching a move written in algebraic notation.
+func (m *ChessModel) parseSAN(text string) (move, error) {
+	want := normalizeSAN(text)
+
+	legal := m.clone().legalMoves()
+	for _, mv := range legal {
+		if m.sanAmong(mv, legal) == want {
+			return mv, nil
+		}
+	}
+
+	return move{}, fmt.Errorf("illegal move: %q", text)
+}
+
+// normalizeSAN strips annotations from a move and accepts common spelling variants.
+func normalizeSAN(text string) string {
+	text = strings.TrimRight(text, "+#!?")
+	text = strings.TrimSuffix(text, "e.p.")
+
+	// Castling is sometimes written with zeros
+	switch text {
+	case "0-0":
+		return "O-O"
+	case "0-0-0":
+		return "O-O-O"
+	}
+
+	// Promotions are sometimes written without the equals sign
+	if n := len(text); n >= 3 && strings.ContainsRune("QRBN", rune(text[n-1])) && text[n-2] >= '1' && text[n-2] <= '8' {
+		return text[:n-1] + "=" + text[n-1:]
+	}
+
+	return text
+}
@@ -9,9 +9,16 @@ type Piece struct {
 	Value int8
 }
 
+type MoveRow struct {
+	Number  int
+	Moves   [2]string
+	Current int
+}
+
 type RenderContext struct {
 	Board      [][]Piece
 	Selected   Position
+	Cursor     Position
 	ValidMoves []Position
 
 	WhiteCapturedPieces []Piece
@@ -19,4 +26,6 @@ type RenderContext struct {
 
 	IsWhiteKingInCheck bool
 	IsBlackKingInCheck bool
+
+	MoveRows []MoveRow
 }
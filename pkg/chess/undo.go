@@ -0,0 +1,83 @@
+package chess
+
+import tea "charm.land/bubbletea/v2"
+
+// pushUndo stores the current game state before a move and clears the redo history.
+func (m *ChessModel) pushUndo() {
+	m.undoStack = append(m.undoStack, m.snapshot())
+	m.redoStack = nil
+}
+
+// handleUndo takes back the last move, or the last full turn against the computer.
+func (m *ChessModel) handleUndo() tea.Cmd {
+	if len(m.undoStack) == 0 {
+		m.message = "Nothing to undo."
+		return nil
+	}
+
+	// Against the computer keep going back until it is the player's move again
+	for len(m.undoStack) > 0 {
+		last := len(m.undoStack) - 1
+		prev := m.undoStack[last]
+		m.undoStack = m.undoStack[:last]
+
+		m.redoStack = append(m.redoStack, m.snapshot())
+		m.restore(prev)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// handleRedo replays the last move that was taken back.
+func (m *ChessModel) handleRedo() tea.Cmd {
+	if len(m.redoStack) == 0 {
+		m.message = "Nothing to redo."
+		return nil
+	}
+
+	for len(m.redoStack) > 0 {
+		last := len(m.redoStack) - 1
+		next := m.redoStack[last]
+		m.redoStack = m.redoStack[:last]
+
+		m.undoStack = append(m.undoStack, m.snapshot())
+		m.restore(next)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// snapshot returns a copy of the game state for the undo history.
+func (m *ChessModel) snapshot() *ChessModel {
+	s := m.clone()
+	s.undoStack = nil
+	s.redoStack = nil
+	s.thinking = false
+	s.message = ""
+	return s
+}
+
+// restore replaces the game state with a snapshot, keeping the history and display settings.
+func (m *ChessModel) restore(s *ChessModel) {
+	undoStack, redoStack := m.undoStack, m.redoStack
+	renderer, searchSeq := m.renderer, m.searchSeq
+	cursor, showCursor := m.cursor, m.showCursor
+
+	*m = *s.clone()
+	m.undoStack = undoStack
+	m.redoStack = redoStack
+	m.renderer = renderer
+	m.cursor = cursor
+	m.showCursor = showCursor
+
+	// Drop any search still running for the position that was left
+	m.searchSeq = searchSeq + 1
+}
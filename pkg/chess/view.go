@@ -8,6 +8,7 @@ import (
 	"ascii-arcade/pkg/chess/renderer/nerdfont"
 	t "ascii-arcade/pkg/chess/types"
 	"ascii-arcade/pkg/overlay"
+	"fmt"
 
 	"image/color"
 
@@ -29,6 +30,14 @@ const (
 
 // View renders the entire Chess board.
 func (m *ChessModel) View() tea.View {
+	if !m.hasSelected {
+		return tea.NewView(m.viewSetup())
+	}
+
+	if m.replay != nil {
+		return tea.NewView(m.viewReplay())
+	}
+
 	renderer := m.getPieceRenderer(m.renderer)
 
 	if m.gameOver {
@@ -39,9 +48,89 @@ func (m *ChessModel) View() tea.View {
 		return tea.NewView(m.viewPawnPromotion(renderer))
 	}
 
+	if status := m.viewStatus(); status != "" {
+		return tea.NewView(lipgloss.JoinVertical(
+			lipgloss.Center,
+			renderer.View(),
+			MessageStyle.Render(status),
+		))
+	}
+
 	return tea.NewView(renderer.View())
 }
 
+// viewStatus returns the line shown below the board, if any.
+func (m *ChessModel) viewStatus() string {
+	switch {
+	case m.typing:
+		return "Move: " + m.input + "▏"
+	case m.message != "":
+		return m.message
+	case m.thinking:
+		return colorName(m.turn) + " is thinking..."
+	}
+	return ""
+}
+
+// viewReplay renders the current position of an imported game with the full move list.
+func (m *ChessModel) viewReplay() string {
+	final := m.replay.positions[len(m.replay.positions)-1]
+
+	context := m.replayPosition().renderContext()
+	context.MoveRows = final.moveRows(m.replay.index - 1)
+
+	status := fmt.Sprintf("Move %d of %d · ←/→ step · enter to play from here",
+		m.replay.index, len(m.replay.positions)-1)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		newPieceRenderer(m.renderer, context).View(),
+		MessageStyle.Render(status),
+	)
+}
+
+// viewSetup renders the game settings menu.
+func (m *ChessModel) viewSetup() string {
+	mode := "Two players"
+	if m.vsComputer {
+		mode = "Computer"
+	}
+
+	entries := []string{
+		m.viewSetupEntry(setupMode, "Opponent", mode),
+	}
+	if m.vsComputer {
+		strength := Strengths[m.strength]
+		entries = append(entries,
+			m.viewSetupEntry(setupSide, "Play as", colorName(m.playerColor)),
+			m.viewSetupEntry(setupStrength, "Strength", fmt.Sprintf("%s (depth %d)", strength.Name, strength.Depth)),
+		)
+	}
+	entries = append(entries, m.viewSetupEntry(setupStart, "Start", ""))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		LabelStyle.Render("Chess"),
+		lipgloss.JoinVertical(lipgloss.Left, entries...),
+	)
+}
+
+// viewSetupEntry renders a single row of the settings menu.
+func (m *ChessModel) viewSetupEntry(row int, name, value string) string {
+	detail := "\n"
+	if value != "" {
+		detail = ListDetail.Render("‹ "+value+" ›") + "\n"
+	}
+
+	var entry string
+	if m.setupRow == row {
+		entry = SelectedListEntry.Render("> "+name) + "\n  " + detail
+	} else {
+		entry = ListEntry.Render(name + "\n" + detail)
+	}
+
+	return zone.Mark(fmt.Sprintf("setup_%d", row), entry)
+}
+
 // viewGameOver renders the end of game UI.
 func (m *ChessModel) viewGameOver(renderer PieceRenderer) string {
 	// Determine game outcome and assign appropriate styling
@@ -55,12 +144,12 @@ func (m *ChessModel) viewGameOver(renderer PieceRenderer) string {
 		winner = "Black wins!"
 		color = colors.Purple
 	default:
-		winner = "Stalemate!"
+		winner = "Draw!"
 		color = colors.Blue
 	}
 
 	winner = lipgloss.NewStyle().Foreground(color).Render(winner)
-	return components.GameOver(color, renderer.View(), winner)
+	return components.GameOver(color, renderer.View(), winner, m.endReason)
 }
 
 // viewPawnPromotion renders the pawn promotion UI.
@@ -79,16 +168,27 @@ func (m *ChessModel) viewPawnPromotion(renderer PieceRenderer) string {
 
 	return overlay.PlaceNotification(
 		mainView,
-		"Select a piece below.",
+		"Select a piece below (q / r / b / n).",
 		pieces,
 	)
 }
 
 // getPieceRenderer returns the appropriate piece renderer.
 func (m *ChessModel) getPieceRenderer(renderer int) PieceRenderer {
-	context := t.RenderContext{
+	return newPieceRenderer(renderer, m.renderContext())
+}
+
+// renderContext collects the game state needed by the renderers.
+func (m *ChessModel) renderContext() t.RenderContext {
+	cursor := pos(-1, -1)
+	if m.showCursor {
+		cursor = m.cursor
+	}
+
+	return t.RenderContext{
 		Board:      m.board,
 		Selected:   m.selected,
+		Cursor:     cursor,
 		ValidMoves: m.validMoves,
 
 		WhiteCapturedPieces: m.whiteCapturedPieces,
@@ -96,8 +196,13 @@ func (m *ChessModel) getPieceRenderer(renderer int) PieceRenderer {
 
 		IsWhiteKingInCheck: m.isWhiteKingInCheck,
 		IsBlackKingInCheck: m.isBlackKingInCheck,
+
+		MoveRows: m.moveRows(len(m.moveHistory) - 1),
 	}
+}
 
+// newPieceRenderer creates the piece renderer for the given style.
+func newPieceRenderer(renderer int, context t.RenderContext) PieceRenderer {
 	switch renderer {
 	case Block:
 		return block.BlockRenderer{RenderContext: context}
@@ -109,3 +214,11 @@ func (m *ChessModel) getPieceRenderer(renderer int) PieceRenderer {
 
 	return nil
 }
+
+// colorName returns the display name for a side.
+func colorName(color int8) string {
+	if color == White {
+		return "White"
+	}
+	return "Black"
+}
@@ -0,0 +1,186 @@
+package connectfour
+
+import (
+	t "ascii-arcade/pkg/connectfour/types"
+)
+
+const winScore = 1_000_000
+
+// columnOrder searches central columns first, which are usually stronger and prune better.
+var columnOrder = [Cols]int{3, 2, 4, 1, 5, 0, 6}
+
+// grid is a fixed-size copy of the board used by the search.
+type grid [Rows][Cols]int8
+
+// aiMoveMsg carries the column chosen by the computer opponent.
+type aiMoveMsg struct {
+	seq int
+	col int
+}
+
+// newGrid copies a board into a fixed-size grid.
+func newGrid(board [][]int8) grid {
+	var g grid
+	for y := range Rows {
+		copy(g[y][:], board[y])
+	}
+	return g
+}
+
+// bestMove searches to the given depth with alpha-beta pruning and returns the best column for color.
+func bestMove(g grid, color int8, depth int) int {
+	best, bestScore := -1, -winScore*2
+	alpha, beta := -winScore*2, winScore*2
+	for _, col := range columnOrder {
+		row := g.drop(col, color)
+		if row == -1 {
+			continue
+		}
+
+		var score int
+		if g.isWin(col, row) {
+			score = winScore + depth
+		} else {
+			score = -g.negamax(-color, depth-1, -beta, -alpha)
+		}
+		g[row][col] = Empty
+
+		if score > bestScore {
+			best, bestScore = col, score
+		}
+		alpha = max(alpha, score)
+	}
+
+	return best
+}
+
+// negamax scores a position from the point of view of the side to move.
+func (g *grid) negamax(color int8, depth, alpha, beta int) int {
+	if depth <= 0 {
+		return g.evaluate(color)
+	}
+
+	moved := false
+	for _, col := range columnOrder {
+		row := g.drop(col, color)
+		if row == -1 {
+			continue
+		}
+		moved = true
+
+		// Prefer quicker wins by rewarding remaining depth
+		var score int
+		if g.isWin(col, row) {
+			score = winScore + depth
+		} else {
+			score = -g.negamax(-color, depth-1, -beta, -alpha)
+		}
+		g[row][col] = Empty
+
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	// A full board is a draw
+	if !moved {
+		return 0
+	}
+
+	return alpha
+}
+
+// drop places a disc in a column and returns the row it landed in, or -1 if the column is full.
+func (g *grid) drop(col int, color int8) int {
+	for y := Rows - 1; y >= 0; y-- {
+		if g[y][col] == Empty {
+			g[y][col] = color
+			return y
+		}
+	}
+	return -1
+}
+
+// isWin reports whether the disc at (col, row) completes a line of four.
+func (g *grid) isWin(col, row int) bool {
+	color := g[row][col]
+	for _, dir := range directions {
+		count := 1 + g.countDirection(col, row, dir, color) + g.countDirection(col, row, t.Position{X: -dir.X, Y: -dir.Y}, color)
+		if count >= 4 {
+			return true
+		}
+	}
+	return false
+}
+
+// countDirection counts consecutive discs of a color starting next to (col, row).
+func (g *grid) countDirection(col, row int, dir t.Position, color int8) int {
+	count := 0
+	x, y := col+dir.X, row+dir.Y
+	for inBounds(x, y) && g[y][x] == color {
+		count++
+		x += dir.X
+		y += dir.Y
+	}
+	return count
+}
+
+// evaluate scores every window of four cells for the side to move.
+func (g *grid) evaluate(color int8) int {
+	score := 0
+
+	// Central discs take part in the most lines
+	for y := range Rows {
+		switch g[y][Cols/2] {
+		case color:
+			score += 3
+		case -color:
+			score -= 3
+		}
+	}
+
+	for y := range Rows {
+		for x := range Cols {
+			for _, dir := range directions {
+				endX, endY := x+3*dir.X, y+3*dir.Y
+				if !inBounds(endX, endY) {
+					continue
+				}
+
+				own, opp := 0, 0
+				for i := range 4 {
+					switch g[y+i*dir.Y][x+i*dir.X] {
+					case color:
+						own++
+					case -color:
+						opp++
+					}
+				}
+				score += scoreWindow(own, opp)
+			}
+		}
+	}
+
+	return score
+}
+
+// scoreWindow rates a window of four cells containing own and opp discs.
+func scoreWindow(own, opp int) int {
+	// Windows holding both colors can never become a line
+	if own > 0 && opp > 0 {
+		return 0
+	}
+
+	switch {
+	case own == 3:
+		return 50
+	case own == 2:
+		return 10
+	case opp == 3:
+		return -80
+	case opp == 2:
+		return -10
+	}
+	return 0
+}
@@ -0,0 +1,394 @@
+package connectfour
+
+import (
+	"fmt"
+
+	t "ascii-arcade/pkg/connectfour/types"
+	"ascii-arcade/pkg/registry"
+
+	tea "charm.land/bubbletea/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+const (
+	Rows = 6
+	Cols = 7
+)
+
+const (
+	Empty = 0
+)
+
+const (
+	White = -1
+	Black = 1
+)
+
+const (
+	minDepth     = 1
+	maxDepth     = 9
+	defaultDepth = 5
+)
+
+// Rows of the setup screen.
+const (
+	setupMode = iota
+	setupSide
+	setupDepth
+	setupStart
+)
+
+// directions lists the four line orientations a win can be made in.
+var directions = []t.Position{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: -1}}
+
+type ConnectFourModel struct {
+	renderer int
+	board    [][]int8
+	turn     int8
+	cursor   int
+	lastMove t.Position
+	winning  []t.Position
+	moves    int
+
+	// Setup
+	hasSelected bool
+	setupRow    int
+	vsComputer  bool
+	playerColor int8
+	depth       int
+
+	// Computer opponent
+	thinking  bool
+	searchSeq int
+
+	whiteWins bool
+	blackWins bool
+	gameOver  bool
+}
+
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Connect Four",
+		Category: registry.Strategy,
+		Order:    4,
+		New:      func() tea.Model { return InitConnectFourModel() },
+	})
+}
+
+// InitConnectFourModel creates a Connect Four model on the setup screen.
+func InitConnectFourModel() *ConnectFourModel {
+	m := newGame(Block, false, White, defaultDepth)
+	m.hasSelected = false
+	return m
+}
+
+// newGame creates a fresh board with the given settings.
+func newGame(renderer int, vsComputer bool, playerColor int8, depth int) *ConnectFourModel {
+	m := ConnectFourModel{
+		renderer:    renderer,
+		board:       InitConnectFourBoard(),
+		turn:        White,
+		cursor:      Cols / 2,
+		lastMove:    t.Position{X: -1, Y: -1},
+		hasSelected: true,
+		vsComputer:  vsComputer,
+		playerColor: playerColor,
+		depth:       depth,
+	}
+
+	return &m
+}
+
+// InitConnectFourBoard returns an empty board.
+func InitConnectFourBoard() [][]int8 {
+	board := make([][]int8, Rows)
+	for y := range board {
+		board[y] = make([]int8, Cols)
+	}
+	return board
+}
+
+// Init implements the Bubble Tea interface for initialization.
+func (m *ConnectFourModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles keypress, mouse, and computer move events.
+func (m *ConnectFourModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case aiMoveMsg:
+		return m.handleComputerMove(msg)
+
+	// Handle keyboard input
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+r":
+			return m.restart()
+		case "ctrl+n":
+			next := InitConnectFourModel()
+			next.renderer = m.renderer
+			next.vsComputer = m.vsComputer
+			next.playerColor = m.playerColor
+			next.depth = m.depth
+			return next, nil
+		case "1":
+			m.renderer = Block
+			return m, nil
+		case "2":
+			m.renderer = Ascii
+			return m, nil
+		case "3":
+			m.renderer = Nerdfont
+			return m, nil
+		}
+
+		if !m.hasSelected {
+			return m.handleSetupKey(msg)
+		}
+		return m.handleKeyPress(msg)
+
+	// Handle mouse input
+	case tea.MouseClickMsg:
+		return m.handleMouseClick(msg)
+	}
+
+	return m, nil
+}
+
+// handleSetupKey handles keyboard input on the setup screen.
+func (m *ConnectFourModel) handleSetupKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "w", "k":
+		m.setupRow = m.prevSetupRow()
+	case "down", "s", "j", "tab":
+		m.setupRow = m.nextSetupRow()
+	case "left", "a", "h":
+		m.changeSetting(m.setupRow, -1)
+	case "right", "d", "l", "space":
+		m.changeSetting(m.setupRow, 1)
+	case "enter":
+		return m.restart()
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input during play.
+func (m *ConnectFourModel) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if m.gameOver {
+		if msg.String() == "enter" {
+			return m, func() tea.Msg { return "home" }
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "left", "a", "h":
+		m.cursor = (m.cursor - 1 + Cols) % Cols
+	case "right", "d", "l":
+		m.cursor = (m.cursor + 1) % Cols
+	case "enter", "space", "down", "s", "j":
+		return m.handlePlayerDrop(m.cursor)
+	}
+
+	return m, nil
+}
+
+// handleMouseClick handles mouse interactions.
+func (m *ConnectFourModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// Only respond to left clicks
+	if msg.Mouse().Button != tea.MouseLeft {
+		return m, nil
+	}
+
+	// Handle the setup screen
+	if !m.hasSelected {
+		for row := setupMode; row <= setupStart; row++ {
+			if !zone.Get(fmt.Sprintf("setup_%d", row)).InBounds(msg) {
+				continue
+			}
+			if row == setupStart {
+				return m.restart()
+			}
+			m.setupRow = row
+			m.changeSetting(row, 1)
+		}
+		return m, nil
+	}
+
+	// Handle game over UI
+	if m.gameOver {
+		switch {
+		case zone.Get("reset").InBounds(msg):
+			return m.restart()
+		case zone.Get("exit").InBounds(msg):
+			return m, func() tea.Msg { return "home" }
+		default:
+			return m, nil
+		}
+	}
+
+	// Clicking anywhere in a column drops a disc into it
+	for x := range Cols {
+		if zone.Get(fmt.Sprintf("col_%d", x)).InBounds(msg) {
+			return m.handlePlayerDrop(x)
+		}
+		for y := range Rows {
+			if zone.Get(fmt.Sprint(y*Cols + x)).InBounds(msg) {
+				return m.handlePlayerDrop(x)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handlePlayerDrop drops the current player's disc and starts the computer's search if needed.
+func (m *ConnectFourModel) handlePlayerDrop(col int) (tea.Model, tea.Cmd) {
+	// Ignore input while the computer is moving
+	if m.isComputerTurn() {
+		return m, nil
+	}
+
+	m.cursor = col
+	if !m.handleDrop(col) {
+		return m, nil
+	}
+
+	return m, m.startComputerTurn()
+}
+
+// handleComputerMove applies the column chosen by the computer opponent.
+func (m *ConnectFourModel) handleComputerMove(msg aiMoveMsg) (tea.Model, tea.Cmd) {
+	// Drop results from searches that belong to an earlier game
+	if msg.seq != m.searchSeq || !m.isComputerTurn() {
+		return m, nil
+	}
+
+	m.thinking = false
+	m.cursor = msg.col
+	m.handleDrop(msg.col)
+
+	return m, nil
+}
+
+// handleDrop places a disc for the side to move, returning false if the column is full.
+func (m *ConnectFourModel) handleDrop(col int) bool {
+	row := -1
+	for y := Rows - 1; y >= 0; y-- {
+		if m.board[y][col] == Empty {
+			row = y
+			break
+		}
+	}
+	if row == -1 {
+		return false
+	}
+
+	m.board[row][col] = m.turn
+	m.lastMove = t.Position{X: col, Y: row}
+	m.moves++
+
+	// Check if the game has ended
+	m.winning = m.winningLine(col, row)
+	if len(m.winning) > 0 {
+		m.whiteWins = m.turn == White
+		m.blackWins = m.turn == Black
+		m.gameOver = true
+		return true
+	}
+
+	if m.moves == Rows*Cols {
+		m.gameOver = true
+		return true
+	}
+
+	m.turn *= -1
+	return true
+}
+
+// winningLine returns the four or more discs through (col, row) that form a line, if any.
+func (m *ConnectFourModel) winningLine(col, row int) []t.Position {
+	color := m.board[row][col]
+	for _, dir := range directions {
+		line := []t.Position{{X: col, Y: row}}
+
+		// Extend the line in both directions from the placed disc
+		for _, sign := range []int{1, -1} {
+			x, y := col+sign*dir.X, row+sign*dir.Y
+			for inBounds(x, y) && m.board[y][x] == color {
+				line = append(line, t.Position{X: x, Y: y})
+				x += sign * dir.X
+				y += sign * dir.Y
+			}
+		}
+
+		if len(line) >= 4 {
+			return line
+		}
+	}
+
+	return nil
+}
+
+// restart begins a new game with the current settings.
+func (m *ConnectFourModel) restart() (tea.Model, tea.Cmd) {
+	next := newGame(m.renderer, m.vsComputer, m.playerColor, m.depth)
+	next.searchSeq = m.searchSeq + 1
+	return next, next.startComputerTurn()
+}
+
+// isComputerTurn reports whether the computer opponent is to move.
+func (m *ConnectFourModel) isComputerTurn() bool {
+	return m.vsComputer && !m.gameOver && m.turn != m.playerColor
+}
+
+// startComputerTurn returns a command that searches for the computer's move off the UI goroutine.
+func (m *ConnectFourModel) startComputerTurn() tea.Cmd {
+	if !m.isComputerTurn() {
+		return nil
+	}
+
+	m.thinking = true
+	seq := m.searchSeq
+	board := newGrid(m.board)
+	color, depth := m.turn, m.depth
+
+	return func() tea.Msg {
+		col := bestMove(board, color, depth)
+		return aiMoveMsg{seq: seq, col: col}
+	}
+}
+
+// changeSetting cycles the value of a setup row in the given direction.
+func (m *ConnectFourModel) changeSetting(row, delta int) {
+	switch row {
+	case setupMode:
+		m.vsComputer = !m.vsComputer
+	case setupSide:
+		m.playerColor *= -1
+	case setupDepth:
+		m.depth = min(max(m.depth+delta, minDepth), maxDepth)
+	}
+}
+
+// nextSetupRow moves down the setup screen, skipping computer options in two player mode.
+func (m *ConnectFourModel) nextSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupMode {
+		return setupStart
+	}
+	return min(m.setupRow+1, setupStart)
+}
+
+// prevSetupRow moves up the setup screen, skipping computer options in two player mode.
+func (m *ConnectFourModel) prevSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupStart {
+		return setupMode
+	}
+	return max(m.setupRow-1, setupMode)
+}
+
+// inBounds reports whether a position is on the board.
+func inBounds(x, y int) bool {
+	return x >= 0 && x < Cols && y >= 0 && y < Rows
+}
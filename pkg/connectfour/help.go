@@ -0,0 +1,146 @@
+package connectfour
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	Header = lipgloss.NewStyle().Foreground(colors.Purple).Render(
+		` ██████╗ ██████╗ ███╗   ██╗███╗   ██╗███████╗ ██████╗████████╗    ██╗  ██╗
+██╔════╝██╔═══██╗████╗  ██║████╗  ██║██╔════╝██╔════╝╚══██╔══╝    ██║  ██║
+██║     ██║   ██║██╔██╗ ██║██╔██╗ ██║█████╗  ██║        ██║       ███████║
+██║     ██║   ██║██║╚██╗██║██║╚██╗██║██╔══╝  ██║        ██║       ╚════██║
+╚██████╗╚██████╔╝██║ ╚████║██║ ╚████║███████╗╚██████╗   ██║            ██║
+ ╚═════╝ ╚═════╝ ╚═╝  ╚═══╝╚═╝  ╚═══╝╚══════╝ ╚═════╝   ╚═╝            ╚═╝`,
+	)
+
+	Intro = `Take turns dropping discs into a seven column grid. The first
+player to line up four discs in a row wins.
+
+• Discs fall to the lowest empty space in the chosen column.
+• Lines can be horizontal, vertical, or diagonal.
+• White always moves first.
+• If the grid fills up with no line of four the game is a draw.
+
+Play against a friend on the same keyboard or against the
+computer. A higher search depth makes the computer stronger
+but slower to move.`
+
+	Rendering = `There are 3 different styles of rendering:
+• Block - Discs drawn using block characters
+• ASCII - Discs are created using ASCII art
+• Nerdfont – Uses Nerdfont circle icons`
+
+	HeaderStyle = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Margin(1, 0).
+			Padding(0, 1).
+			Bold(true)
+
+	PieceBox = lipgloss.NewStyle().
+			Margin(0, 4, 1, 4)
+
+	PieceStyle = lipgloss.NewStyle().Foreground(colors.Purple)
+)
+
+// Help returns the Connect Four help screen UI.
+func (m *ConnectFourModel) Help() string {
+	howToPlay := components.Section("How To Play", Intro)
+
+	// Combine all help menu sections vertically
+	menu := lipgloss.JoinVertical(
+		lipgloss.Left,
+		howToPlay,
+		createRendererExamples(),
+	)
+
+	// Define keybindings specific to the game
+	gameKeybinds := []components.Keybind{
+		{Key: "← / a / h", Action: "column left"},
+		{Key: "→ / d / l", Action: "column right"},
+		{Key: "enter / ↓", Action: "drop disc"},
+		{Key: "click", Action: "drop disc"},
+		{Key: "1 / 2 / 3", Action: "change renderer"},
+		{Key: "ctrl+n", Action: "game settings"},
+	}
+
+	keybinds := components.JoinKeybinds(
+		components.ViewWideKeybinds("Game", gameKeybinds),
+		components.GlobalKeybinds(),
+	)
+
+	return components.CreateHelpMenu(Header, menu, keybinds)
+}
+
+// createRendererExamples returns the renderer examples section for the Connect Four help screen.
+func createRendererExamples() string {
+	block := PieceBox.Render(
+		lipgloss.JoinVertical(
+			lipgloss.Center,
+			HeaderStyle.Render("Block"),
+			blockDisc(),
+		),
+	)
+
+	ascii := PieceBox.Render(
+		lipgloss.JoinVertical(
+			lipgloss.Center,
+			HeaderStyle.Render("ASCII"),
+			asciiDisc(),
+		),
+	)
+
+	nerdfont := PieceBox.Render(
+		lipgloss.JoinVertical(
+			lipgloss.Center,
+			HeaderStyle.Render("Nerdfont"),
+			nerdfontDisc(),
+		),
+	)
+
+	pieces := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		block,
+		ascii,
+		nerdfont,
+	)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		Rendering,
+		pieces,
+		"The style can be changed by pressing 1 / 2 / 3.",
+	)
+
+	return components.Section("Rendering Styles", content)
+}
+
+// blockDisc returns the block disc example.
+func blockDisc() string {
+	return strings.Join([]string{
+		PieceStyle.Render(`  ▄▄▄  `),
+		PieceStyle.Render(`▄█████▄`),
+		PieceStyle.Render(`▀█████▀`),
+		PieceStyle.Render(`  ▀▀▀  `),
+	}, "\n")
+}
+
+// asciiDisc returns the ASCII disc example.
+func asciiDisc() string {
+	return strings.Join([]string{
+		PieceStyle.Render(` ,gPPRg, `),
+		PieceStyle.Render(`dP' X 'Yb`),
+		PieceStyle.Render(`Yb  X  dP`),
+		PieceStyle.Render(` "8ggg8" `),
+	}, "\n")
+}
+
+// nerdfontDisc returns the Nerdfont disc example.
+func nerdfontDisc() string {
+	return PieceStyle.Margin(1).Render(" ")
+}
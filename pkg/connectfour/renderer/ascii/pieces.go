@@ -0,0 +1,41 @@
+package ascii
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+func (r AsciiRenderer) hole(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(` ,-"""-, `),
+		style.Render(`/       \`),
+		style.Render(`\       /`),
+		style.Render(` '-...-' `),
+	}, "\n")
+}
+
+func (r AsciiRenderer) white(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(` ,gPPRg, `),
+		style.Render(`dP' O 'Yb`),
+		style.Render(`Yb  O  dP`),
+		style.Render(` "8ggg8" `),
+	}, "\n")
+}
+
+func (r AsciiRenderer) black(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(` ,gPPRg, `),
+		style.Render(`dP' X 'Yb`),
+		style.Render(`Yb  X  dP`),
+		style.Render(` "8ggg8" `),
+	}, "\n")
+}
+
+func (r AsciiRenderer) marker(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(`\___/`),
+		style.Render(` \ / `),
+	}, "\n")
+}
@@ -0,0 +1,23 @@
+package block
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+func (r BlockRenderer) disc(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(`  ▄▄▄  `),
+		style.Render(`▄█████▄`),
+		style.Render(`▀█████▀`),
+		style.Render(`  ▀▀▀  `),
+	}, "\n")
+}
+
+func (r BlockRenderer) marker(style lipgloss.Style) string {
+	return strings.Join([]string{
+		style.Render(`▄▄▄▄▄`),
+		style.Render(` ▀█▀ `),
+	}, "\n")
+}
@@ -0,0 +1,45 @@
+package block
+
+import (
+	"ascii-arcade/internal/colors"
+
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	Empty = 0
+)
+
+const (
+	White = -1
+	Black = 1
+)
+
+var (
+	Width  = 9
+	Height = 4
+
+	CWhite = colors.Orange
+	CBlack = colors.Purple
+	CHole  = colors.Dark1
+	CWin   = colors.Pink
+	CBoard = colors.Blue
+
+	WhitePiece = lipgloss.NewStyle().Foreground(CWhite).Bold(true)
+	BlackPiece = lipgloss.NewStyle().Foreground(CBlack).Bold(true)
+	HolePiece  = lipgloss.NewStyle().Foreground(CHole)
+	WinPiece   = lipgloss.NewStyle().Foreground(CWin).Bold(true)
+
+	EmptyCell = lipgloss.NewStyle().
+			Align(lipgloss.Center).
+			Width(Width).
+			Height(Height)
+
+	BoardCell = EmptyCell.
+			Background(CBoard)
+
+	CursorCell = lipgloss.NewStyle().
+			Align(lipgloss.Center, lipgloss.Bottom).
+			Width(Width).
+			Height(2)
+)
@@ -0,0 +1,68 @@
+package block
+
+import (
+	t "ascii-arcade/pkg/connectfour/types"
+	"fmt"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+type BlockRenderer struct {
+	t.RenderContext
+}
+
+// View renders the full block style Connect Four board.
+func (r BlockRenderer) View() string {
+	rows := make([]string, 0, len(r.Board)+1)
+	rows = append(rows, r.viewCursorRow())
+
+	for y := range r.Board {
+		row := make([]string, len(r.Board[y]))
+		for x, color := range r.Board[y] {
+			// Use a unique zone label for mouse interactivity
+			label := fmt.Sprint(y*len(r.Board[y]) + x)
+			row[x] = zone.Mark(label, BoardCell.Render(r.viewDisc(x, y, color)))
+		}
+
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// viewCursorRow renders the drop marker above the selected column.
+func (r BlockRenderer) viewCursorRow() string {
+	cols := len(r.Board[0])
+	row := make([]string, cols)
+	for x := range cols {
+		content := ""
+		if x == r.Cursor {
+			content = r.marker(pieceStyle(r.Turn))
+		}
+		row[x] = zone.Mark(fmt.Sprintf("col_%d", x), CursorCell.Render(content))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, row...)
+}
+
+// viewDisc renders the disc or empty hole at a board position.
+func (r BlockRenderer) viewDisc(x, y int, color int8) string {
+	switch {
+	case color == Empty:
+		return r.disc(HolePiece)
+	case slices.Contains(r.Winning, t.Position{X: x, Y: y}):
+		return r.disc(WinPiece)
+	default:
+		return r.disc(pieceStyle(color))
+	}
+}
+
+// pieceStyle returns the appropriate style for the given disc color.
+func pieceStyle(color int8) lipgloss.Style {
+	if color == White {
+		return WhitePiece
+	}
+	return BlackPiece
+}
@@ -0,0 +1,17 @@
+package nerdfont
+
+import (
+	"charm.land/lipgloss/v2"
+)
+
+func (r NerdfontRenderer) hole(style lipgloss.Style) string {
+	return style.Render(" ")
+}
+
+func (r NerdfontRenderer) disc(style lipgloss.Style) string {
+	return style.Render(" ")
+}
+
+func (r NerdfontRenderer) marker(style lipgloss.Style) string {
+	return style.Render(" ")
+}
@@ -0,0 +1,77 @@
+package nerdfont
+
+import (
+	t "ascii-arcade/pkg/connectfour/types"
+	"fmt"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+type NerdfontRenderer struct {
+	t.RenderContext
+}
+
+// View renders the full nerdfont style Connect Four board.
+func (r NerdfontRenderer) View() string {
+	rows := make([]string, 0, 2*len(r.Board)+2)
+	rows = append(rows, r.viewCursorRow())
+
+	for y := range r.Board {
+		rows = append(rows, r.viewMarginRow())
+
+		row := make([]string, len(r.Board[y]))
+		for x, color := range r.Board[y] {
+			// Use a unique zone label for mouse interactivity
+			label := fmt.Sprint(y*len(r.Board[y]) + x)
+			row[x] = zone.Mark(label, BoardCell.Render(r.viewDisc(x, y, color)))
+		}
+
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+
+	rows = append(rows, r.viewMarginRow())
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// viewMarginRow renders a blank strip of board between rows of holes.
+func (r NerdfontRenderer) viewMarginRow() string {
+	return BoardCell.Width(Width * len(r.Board[0])).Render("")
+}
+
+// viewCursorRow renders the drop marker above the selected column.
+func (r NerdfontRenderer) viewCursorRow() string {
+	cols := len(r.Board[0])
+	row := make([]string, cols)
+	for x := range cols {
+		content := ""
+		if x == r.Cursor {
+			content = r.marker(pieceStyle(r.Turn))
+		}
+		row[x] = zone.Mark(fmt.Sprintf("col_%d", x), CursorCell.Render(content))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, row...)
+}
+
+// viewDisc renders the disc or empty hole at a board position.
+func (r NerdfontRenderer) viewDisc(x, y int, color int8) string {
+	switch {
+	case color == Empty:
+		return r.hole(HolePiece)
+	case slices.Contains(r.Winning, t.Position{X: x, Y: y}):
+		return r.disc(WinPiece)
+	default:
+		return r.disc(pieceStyle(color))
+	}
+}
+
+// pieceStyle returns the appropriate style for the given disc color.
+func pieceStyle(color int8) lipgloss.Style {
+	if color == White {
+		return WhitePiece
+	}
+	return BlackPiece
+}
@@ -0,0 +1,34 @@
+package connectfour
+
+import (
+	"ascii-arcade/internal/colors"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	LabelStyle = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	ListEntry = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			MarginLeft(2)
+
+	SelectedListEntry = lipgloss.NewStyle().
+				Foreground(colors.Pink)
+
+	ListDetail = lipgloss.NewStyle().
+			Foreground(colors.Medium2)
+
+	WhiteText = lipgloss.NewStyle().Foreground(colors.Orange).Bold(true)
+	BlackText = lipgloss.NewStyle().Foreground(colors.Purple).Bold(true)
+
+	MessageStyle = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			MarginTop(1).
+			Bold(true)
+)
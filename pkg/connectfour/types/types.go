@@ -0,0 +1,13 @@
+package types
+
+type Position struct {
+	X, Y int
+}
+
+type RenderContext struct {
+	Board    [][]int8
+	Turn     int8
+	Cursor   int
+	LastMove Position
+	Winning  []Position
+}
@@ -0,0 +1,161 @@
+package connectfour
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+	"ascii-arcade/pkg/connectfour/renderer/ascii"
+	"ascii-arcade/pkg/connectfour/renderer/block"
+	"ascii-arcade/pkg/connectfour/renderer/nerdfont"
+	t "ascii-arcade/pkg/connectfour/types"
+	"fmt"
+	"image/color"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+type BoardRenderer interface {
+	View() string
+}
+
+const (
+	Block = iota
+	Ascii
+	Nerdfont
+)
+
+// View renders the entire Connect Four UI.
+func (m *ConnectFourModel) View() tea.View {
+	if !m.hasSelected {
+		return tea.NewView(m.viewSetup())
+	}
+
+	renderer := m.getBoardRenderer(m.renderer)
+	if m.gameOver {
+		return tea.NewView(m.viewGameOver(renderer))
+	}
+
+	return tea.NewView(m.viewGame(renderer))
+}
+
+// viewSetup renders the game settings menu.
+func (m *ConnectFourModel) viewSetup() string {
+	mode := "Two players"
+	if m.vsComputer {
+		mode = "Computer"
+	}
+
+	entries := []string{
+		m.viewSetupEntry(setupMode, "Opponent", mode),
+	}
+	if m.vsComputer {
+		entries = append(entries,
+			m.viewSetupEntry(setupSide, "Play as", colorName(m.playerColor)),
+			m.viewSetupEntry(setupDepth, "Depth", fmt.Sprintf("%d moves ahead", m.depth)),
+		)
+	}
+	entries = append(entries, m.viewSetupEntry(setupStart, "Start", ""))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		LabelStyle.Render("Connect Four"),
+		lipgloss.JoinVertical(lipgloss.Left, entries...),
+	)
+}
+
+// viewSetupEntry renders a single row of the settings menu.
+func (m *ConnectFourModel) viewSetupEntry(row int, name, value string) string {
+	detail := ""
+	if value != "" {
+		detail = ListDetail.Render("‹ "+value+" ›") + "\n"
+	} else {
+		detail = "\n"
+	}
+
+	var entry string
+	if m.setupRow == row {
+		entry = SelectedListEntry.Render("> "+name) + "\n  " + detail
+	} else {
+		entry = ListEntry.Render(name + "\n" + detail)
+	}
+
+	return zone.Mark(fmt.Sprintf("setup_%d", row), entry)
+}
+
+// viewGame renders the board with the current turn below it.
+func (m *ConnectFourModel) viewGame(renderer BoardRenderer) string {
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		renderer.View(),
+		MessageStyle.Render(m.viewStatus()),
+	)
+}
+
+// viewStatus describes whose turn it is.
+func (m *ConnectFourModel) viewStatus() string {
+	name := colorName(m.turn)
+	style := BlackText
+	if m.turn == White {
+		style = WhiteText
+	}
+
+	switch {
+	case m.thinking:
+		return style.Render(name) + " is thinking..."
+	case m.vsComputer:
+		return style.Render(name) + " to move (you)"
+	default:
+		return style.Render(name) + " to move"
+	}
+}
+
+// viewGameOver renders the end of game UI.
+func (m *ConnectFourModel) viewGameOver(renderer BoardRenderer) string {
+	// Determine game outcome and assign appropriate styling
+	var winner string
+	var color color.Color
+	switch {
+	case m.whiteWins:
+		winner = "White wins!"
+		color = colors.Orange
+	case m.blackWins:
+		winner = "Black wins!"
+		color = colors.Purple
+	default:
+		winner = "Draw!"
+		color = colors.Blue
+	}
+
+	winner = lipgloss.NewStyle().Foreground(color).Render(winner)
+	return components.GameOver(color, renderer.View(), winner)
+}
+
+// getBoardRenderer returns the appropriate board renderer.
+func (m *ConnectFourModel) getBoardRenderer(renderer int) BoardRenderer {
+	context := t.RenderContext{
+		Board:    m.board,
+		Turn:     m.turn,
+		Cursor:   m.cursor,
+		LastMove: m.lastMove,
+		Winning:  m.winning,
+	}
+
+	switch renderer {
+	case Block:
+		return block.BlockRenderer{RenderContext: context}
+	case Ascii:
+		return ascii.AsciiRenderer{RenderContext: context}
+	case Nerdfont:
+		return nerdfont.NerdfontRenderer{RenderContext: context}
+	}
+
+	return nil
+}
+
+// colorName returns the display name for a side.
+func colorName(color int8) string {
+	if color == White {
+		return "White"
+	}
+	return "Black"
+}
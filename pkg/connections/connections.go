@@ -6,6 +6,8 @@ import (
 	"slices"
 	"time"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
 )
@@ -30,6 +32,15 @@ type ConnectionsModel struct {
 	message            string
 }
 
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Connections",
+		Category: registry.NYT,
+		Order:    4,
+		New:      func() tea.Model { return InitConnectionsModel() },
+	})
+}
+
 // InitConnectionsModel initializes a new connections model.
 func InitConnectionsModel() *ConnectionsModel {
 	today := time.Now().Format("2006-01-02")
@@ -4,11 +4,10 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"sync"
-	"time"
+
+	"ascii-arcade/pkg/puzzles"
 
 	_ "modernc.org/sqlite"
 )
@@ -38,7 +37,7 @@ type Card struct {
 func LoadGame(date string) (ConnectionsModel, error) {
 	// Try loading the saved game from the database
 	model, err := LoadFromFile(date)
-	if err == nil {
+	if err == nil && hasPuzzle(model.wordGroups) {
 		return model, nil
 	}
 
@@ -58,28 +57,12 @@ func LoadGame(date string) (ConnectionsModel, error) {
 	return model, nil
 }
 
-// fetchConnectionsGroups fetches the Connections groups for today from the NYT API.
+// fetchConnectionsGroups fetches the Connections groups for a date from the puzzle source.
 func fetchConnectionsGroups(date string) ([4]WordGroup, error) {
-	url := fmt.Sprintf("https://www.nytimes.com/svc/connections/v2/%s.json", date)
 	var groups [4]WordGroup
-
-	// Make the GET request
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Get(url)
+	body, err := puzzles.Fetch(fmt.Sprintf("connections/v2/%s.json", date))
 	if err != nil {
-		return groups, fmt.Errorf("error fetching Connections game: %v", err)
-	}
-	defer resp.Body.Close()
-
-	// Check HTTP status
-	if resp.StatusCode != http.StatusOK {
-		return groups, fmt.Errorf("non-OK HTTP status: %d", resp.StatusCode)
-	}
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return groups, fmt.Errorf("error reading response body: %v", err)
+		return groups, fmt.Errorf("error fetching Connections game: %w", err)
 	}
 
 	// Decode JSON
@@ -105,8 +88,13 @@ func fetchConnectionsGroups(date string) ([4]WordGroup, error) {
 	return groups, nil
 }
 
-// SaveToFile persists the current connections game state to a SQLite database.
+// SaveToFile persists the current connections game state to a SQLite database. Games that
+// failed to load have no word groups and are not saved, so the puzzle is fetched again next time.
 func (m *ConnectionsModel) SaveToFile() error {
+	if !hasPuzzle(m.wordGroups) {
+		return nil
+	}
+
 	db, err := getDB()
 	if err != nil {
 		return err
@@ -161,6 +149,11 @@ func LoadFromFile(date string) (ConnectionsModel, error) {
 	return model, nil
 }
 
+// hasPuzzle reports whether a game's word groups were loaded.
+func hasPuzzle(groups [4]WordGroup) bool {
+	return groups[0].Members != [4]string{}
+}
+
 var (
 	dbOnce sync.Once
 	dbConn *sql.DB
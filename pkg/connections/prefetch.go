@@ -0,0 +1,57 @@
+package connections
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// FirstDate is the date of the first Connections puzzle.
+const FirstDate = "2023-06-12"
+
+// storeMu serialises prefetch writes so concurrent downloads do not contend for the database.
+var storeMu sync.Mutex
+
+// Stored reports whether the puzzle for a date is already saved. Rows written for a game that
+// failed to load have no word groups and do not count.
+func Stored(date string) (bool, error) {
+	db, err := getDB()
+	if err != nil {
+		return false, err
+	}
+
+	var wordGroupsJSON []byte
+	err = db.QueryRow(`SELECT word_groups FROM connections WHERE date = ?`, date).Scan(&wordGroupsJSON)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var wordGroups [4]WordGroup
+	json.Unmarshal(wordGroupsJSON, &wordGroups)
+	return hasPuzzle(wordGroups), nil
+}
+
+// Prefetch downloads the puzzle for a date and saves it as an unplayed game, leaving
+// games that are already saved untouched.
+func Prefetch(date string) error {
+	groups, err := fetchConnectionsGroups(date)
+	if err != nil {
+		return err
+	}
+
+	storeMu.Lock()
+	defer storeMu.Unlock()
+
+	// The game may have been opened while the download was running
+	if stored, err := Stored(date); err != nil || stored {
+		return err
+	}
+
+	model := ConnectionsModel{date: date, wordGroups: groups}
+	model.handleReset()
+	return model.SaveToFile()
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 	"unicode"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 )
 
@@ -54,6 +56,21 @@ type CrosswordModel struct {
 	message      string
 }
 
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Crossword",
+		Category: registry.NYT,
+		Order:    1,
+		New:      func() tea.Model { return InitCrosswordModel() },
+	})
+	registry.Register(registry.Game{
+		Name:     "Mini",
+		Category: registry.NYT,
+		Order:    2,
+		New:      func() tea.Model { return InitMiniModel() },
+	})
+}
+
 // InitCrosswordModel creates and initializes a new daily Crossword model.
 func InitCrosswordModel() *CrosswordModel {
 	return initModel(KindDaily)
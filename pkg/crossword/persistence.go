@@ -4,12 +4,11 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strconv"
 	"sync"
-	"time"
+
+	"ascii-arcade/pkg/puzzles"
 
 	_ "modernc.org/sqlite"
 )
@@ -53,7 +52,7 @@ type Dimensions struct {
 func LoadGame(kind Kind, date string) (CrosswordModel, error) {
 	// Try loading the saved game from the database
 	model, err := LoadFromFile(kind, date)
-	if err == nil {
+	if err == nil && len(model.answer) > 0 {
 		return model, nil
 	}
 
@@ -66,30 +65,13 @@ func LoadGame(kind Kind, date string) (CrosswordModel, error) {
 	return model, nil
 }
 
-// fetchCrosswordGame fetches the Crossword game data for today from the NYT API.
+// fetchCrosswordGame fetches the Crossword game data for a date from the puzzle source.
 func fetchCrosswordGame(kind Kind, date string) (CrosswordModel, error) {
 	var model CrosswordModel
-	url := fmt.Sprintf("https://www.nytimes.com/svc/crosswords/v6/puzzle/%s/%s.json", kind, date)
-
-	// Make the GET request
-	req, err := http.NewRequest("GET", url, nil)
-	req.Header.Set("X-Games-Auth-Bypass", "true")
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return model, fmt.Errorf("error fetching Crossword game: %v", err)
-	}
-	defer resp.Body.Close()
 
-	// Check HTTP status
-	if resp.StatusCode != http.StatusOK {
-		return model, fmt.Errorf("non-OK HTTP status: %d", resp.StatusCode)
-	}
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
+	body, err := puzzles.Fetch(fmt.Sprintf("crosswords/v6/puzzle/%s/%s.json", kind, date))
 	if err != nil {
-		return model, fmt.Errorf("error reading response body: %v", err)
+		return model, fmt.Errorf("error fetching Crossword game: %w", err)
 	}
 
 	// Decode JSON
@@ -178,8 +160,13 @@ func fetchCrosswordGame(kind Kind, date string) (CrosswordModel, error) {
 	return model, nil
 }
 
-// SaveToFile persists the current crossword puzzle state to a SQLite database.
+// SaveToFile persists the current crossword puzzle state to a SQLite database. Puzzles that
+// failed to load have no answer grid and are not saved, so they are fetched again next time.
 func (m *CrosswordModel) SaveToFile() error {
+	if len(m.answer) == 0 {
+		return nil
+	}
+
 	db, err := getDB()
 	if err != nil {
 		return err
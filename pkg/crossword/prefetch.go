@@ -0,0 +1,52 @@
+package crossword
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// storeMu serialises prefetch writes so concurrent downloads do not contend for the database.
+var storeMu sync.Mutex
+
+// Stored reports whether the puzzle of a kind for a date is already saved. Rows written for a
+// puzzle that failed to load have no answer grid and do not count.
+func Stored(kind Kind, date string) (bool, error) {
+	db, err := getDB()
+	if err != nil {
+		return false, err
+	}
+
+	var answerJSON []byte
+	err = db.QueryRow(`SELECT answer FROM crosswords WHERE kind = ? AND date = ?`, string(kind), date).Scan(&answerJSON)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var answer [][]byte
+	json.Unmarshal(answerJSON, &answer)
+	return len(answer) > 0, nil
+}
+
+// Prefetch downloads the puzzle of a kind for a date and saves it as an unplayed game,
+// leaving games that are already saved untouched.
+func Prefetch(kind Kind, date string) error {
+	model, err := fetchCrosswordGame(kind, date)
+	if err != nil {
+		return err
+	}
+
+	storeMu.Lock()
+	defer storeMu.Unlock()
+
+	// The game may have been opened while the download was running
+	if stored, err := Stored(kind, date); err != nil || stored {
+		return err
+	}
+
+	return model.SaveToFile()
+}
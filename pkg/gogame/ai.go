@@ -0,0 +1,435 @@
+package gogame
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Strength describes how many random games the computer plays before choosing a move.
+type Strength struct {
+	Name string
+
+	// Playouts is the number of random games played per move for each board size
+	Playouts map[int]int
+}
+
+// Strengths lists the computer levels offered on the setup screen.
+var Strengths = []Strength{
+	{Name: "Beginner", Playouts: map[int]int{BoardSize9: 300, BoardSize13: 150, BoardSize19: 80}},
+	{Name: "Casual", Playouts: map[int]int{BoardSize9: 1500, BoardSize13: 700, BoardSize19: 300}},
+	{Name: "Club", Playouts: map[int]int{BoardSize9: 5000, BoardSize13: 2500, BoardSize19: 1000}},
+	{Name: "Strong", Playouts: map[int]int{BoardSize9: 15000, BoardSize13: 6000, BoardSize19: 2500}},
+}
+
+const defaultStrength = 1
+
+// passMove stands for a pass in the search.
+const passMove = -1
+
+// explorationWeight balances trying new moves against playing the best ones found so far.
+const explorationWeight = 0.8
+
+// aiMoveMsg carries the move chosen by the computer opponent, or nil for a pass.
+type aiMoveMsg struct {
+	seq  int
+	move *Position
+}
+
+// playout is a fast board used by the search, with points stored in a single slice.
+type playout struct {
+	cells  []int8
+	turn   int8
+	ko     int
+	passes int
+}
+
+// node is a move in the search tree with the results of the games played through it.
+type node struct {
+	move     int
+	player   int8
+	parent   *node
+	children []*node
+	untried  []int
+	visits   int
+	wins     float64
+}
+
+// searcher holds the board geometry and scratch space shared by every playout of a search.
+type searcher struct {
+	size      int
+	komi      float64
+	neighbors [][]int
+	diagonals [][]int
+	rng       *rand.Rand
+
+	mark  []int
+	stamp int
+	stack []int
+}
+
+// bestMove runs a Monte Carlo tree search from the current position and
+// returns the chosen move, or nil to pass.
+func bestMove(m *GoModel, playouts int) *Position {
+	s := newSearcher(m.boardSize)
+	s.komi = m.komi
+	root := s.fromModel(m)
+
+	// Only moves the real rules allow, including superko, are considered at the root
+	rootNode := &node{move: passMove, player: m.turn * -1}
+	for _, p := range s.candidates(root) {
+		pos := Position{X: p % s.size, Y: p / s.size}
+		if m.checkMove(pos) == nil {
+			rootNode.untried = append(rootNode.untried, p)
+		}
+	}
+
+	// Passing is only worth considering once the opponent has passed or there is nothing left to play
+	opponentPassed := (m.turn == Black && m.whitePassed) || (m.turn == White && m.blackPassed)
+	if opponentPassed || len(rootNode.untried) == 0 {
+		rootNode.untried = append(rootNode.untried, passMove)
+	}
+	if opponentPassed {
+		root.passes = 1
+	}
+
+	for range playouts {
+		s.iterate(rootNode, root)
+	}
+
+	best := rootNode.children[0]
+	for _, child := range rootNode.children[1:] {
+		if child.visits > best.visits {
+			best = child
+		}
+	}
+
+	if best.move == passMove {
+		return nil
+	}
+	return &Position{X: best.move % s.size, Y: best.move / s.size}
+}
+
+// newSearcher precomputes the neighbours of every point for a board size.
+func newSearcher(size int) *searcher {
+	s := &searcher{
+		size:      size,
+		neighbors: make([][]int, size*size),
+		diagonals: make([][]int, size*size),
+		rng:       rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		mark:      make([]int, size*size),
+	}
+
+	for y := range size {
+		for x := range size {
+			p := y*size + x
+			for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+				if nx, ny := x+d[0], y+d[1]; nx >= 0 && nx < size && ny >= 0 && ny < size {
+					s.neighbors[p] = append(s.neighbors[p], ny*size+nx)
+				}
+			}
+			for _, d := range [][2]int{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+				if nx, ny := x+d[0], y+d[1]; nx >= 0 && nx < size && ny >= 0 && ny < size {
+					s.diagonals[p] = append(s.diagonals[p], ny*size+nx)
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+// fromModel copies the game state into a playout board.
+func (s *searcher) fromModel(m *GoModel) *playout {
+	p := &playout{
+		cells: make([]int8, s.size*s.size),
+		turn:  m.turn,
+		ko:    passMove,
+	}
+
+	for y := range s.size {
+		copy(p.cells[y*s.size:], m.board.Cells[y])
+	}
+	if m.koPoint != nil {
+		p.ko = m.koPoint.Y*s.size + m.koPoint.X
+	}
+
+	return p
+}
+
+// iterate plays one game from the root: it walks down the tree, adds a new node,
+// finishes the game with random moves, and records the result along the path.
+func (s *searcher) iterate(root *node, start *playout) {
+	p := &playout{cells: make([]int8, len(start.cells))}
+	copy(p.cells, start.cells)
+	p.turn, p.ko, p.passes = start.turn, start.ko, start.passes
+
+	// Selection
+	n := root
+	for len(n.untried) == 0 && len(n.children) > 0 {
+		n = n.selectChild()
+		s.play(p, n.move)
+	}
+
+	// Expansion, skipping moves that turn out to be illegal in this line
+	for len(n.untried) > 0 && p.passes < 2 {
+		i := s.rng.IntN(len(n.untried))
+		move := n.untried[i]
+		n.untried[i] = n.untried[len(n.untried)-1]
+		n.untried = n.untried[:len(n.untried)-1]
+
+		player := p.turn
+		if !s.play(p, move) {
+			continue
+		}
+
+		child := &node{move: move, player: player, parent: n}
+		if p.passes < 2 {
+			child.untried = s.candidates(p)
+			if len(child.untried) == 0 {
+				child.untried = []int{passMove}
+			}
+		}
+		n.children = append(n.children, child)
+		n = child
+		break
+	}
+
+	// Simulation
+	s.simulate(p)
+	winner := s.winner(p)
+
+	// Backpropagation
+	for ; n != nil; n = n.parent {
+		n.visits++
+		if n.player == winner {
+			n.wins++
+		}
+	}
+}
+
+// selectChild picks the child with the best upper confidence bound.
+func (n *node) selectChild() *node {
+	var best *node
+	bestScore := math.Inf(-1)
+	logVisits := math.Log(float64(n.visits))
+
+	for _, child := range n.children {
+		score := child.wins/float64(child.visits) +
+			explorationWeight*math.Sqrt(logVisits/float64(child.visits))
+		if score > bestScore {
+			best, bestScore = child, score
+		}
+	}
+
+	return best
+}
+
+// candidates returns the empty points the side to move could reasonably play,
+// leaving out its own eyes.
+func (s *searcher) candidates(p *playout) []int {
+	var moves []int
+	for pt, c := range p.cells {
+		if c == Empty && pt != p.ko && !s.isEye(p, pt, p.turn) {
+			moves = append(moves, pt)
+		}
+	}
+	return moves
+}
+
+// simulate finishes the game with random moves, passing when no sensible move is left.
+func (s *searcher) simulate(p *playout) {
+	maxMoves := 3 * len(p.cells)
+	empties := make([]int, 0, len(p.cells))
+
+	for i := 0; i < maxMoves && p.passes < 2; i++ {
+		empties = empties[:0]
+		for pt, c := range p.cells {
+			if c == Empty {
+				empties = append(empties, pt)
+			}
+		}
+
+		// Try empty points in random order until one is a legal move that does not fill an eye
+		played := false
+		for len(empties) > 0 {
+			j := s.rng.IntN(len(empties))
+			pt := empties[j]
+			empties[j] = empties[len(empties)-1]
+			empties = empties[:len(empties)-1]
+
+			if s.isEye(p, pt, p.turn) {
+				continue
+			}
+			if s.play(p, pt) {
+				played = true
+				break
+			}
+		}
+
+		if !played {
+			s.play(p, passMove)
+		}
+	}
+}
+
+// play makes a move for the side to move, returning false if it is illegal.
+// Simple ko and suicide are checked; superko is only enforced at the root.
+func (s *searcher) play(p *playout, pt int) bool {
+	if pt == passMove {
+		p.passes++
+		p.ko = passMove
+		p.turn *= -1
+		return true
+	}
+
+	if p.cells[pt] != Empty || pt == p.ko {
+		return false
+	}
+
+	color := p.turn
+	p.cells[pt] = color
+
+	// Remove opponent groups left without liberties
+	captured, lastCaptured := 0, passMove
+	for _, n := range s.neighbors[pt] {
+		if p.cells[n] == color*-1 && s.liberties(p, n, 1) == 0 {
+			lastCaptured = n
+			captured += s.removeGroup(p, n)
+		}
+	}
+
+	// Suicide is not allowed
+	if captured == 0 && s.liberties(p, pt, 1) == 0 {
+		p.cells[pt] = Empty
+		return false
+	}
+
+	// A ko arises when a single stone is captured by a group left in atari
+	p.ko = passMove
+	if captured == 1 && s.liberties(p, pt, 2) == 1 {
+		p.ko = lastCaptured
+	}
+
+	p.passes = 0
+	p.turn *= -1
+	return true
+}
+
+// liberties counts the liberties of the group at a point, stopping once limit is reached.
+func (s *searcher) liberties(p *playout, pt, limit int) int {
+	s.stamp++
+	color := p.cells[pt]
+	libs := 0
+
+	s.stack = append(s.stack[:0], pt)
+	s.mark[pt] = s.stamp
+	for len(s.stack) > 0 {
+		cur := s.stack[len(s.stack)-1]
+		s.stack = s.stack[:len(s.stack)-1]
+
+		for _, n := range s.neighbors[cur] {
+			if s.mark[n] == s.stamp {
+				continue
+			}
+
+			switch p.cells[n] {
+			case Empty:
+				s.mark[n] = s.stamp
+				libs++
+				if libs >= limit {
+					return libs
+				}
+			case color:
+				s.mark[n] = s.stamp
+				s.stack = append(s.stack, n)
+			}
+		}
+	}
+
+	return libs
+}
+
+// removeGroup takes the group at a point off the board and returns its size.
+func (s *searcher) removeGroup(p *playout, pt int) int {
+	color := p.cells[pt]
+	removed := 0
+
+	s.stack = append(s.stack[:0], pt)
+	p.cells[pt] = Empty
+	for len(s.stack) > 0 {
+		cur := s.stack[len(s.stack)-1]
+		s.stack = s.stack[:len(s.stack)-1]
+		removed++
+
+		for _, n := range s.neighbors[cur] {
+			if p.cells[n] == color {
+				p.cells[n] = Empty
+				s.stack = append(s.stack, n)
+			}
+		}
+	}
+
+	return removed
+}
+
+// isEye reports whether an empty point is a true eye of the given color.
+func (s *searcher) isEye(p *playout, pt int, color int8) bool {
+	for _, n := range s.neighbors[pt] {
+		if p.cells[n] != color {
+			return false
+		}
+	}
+
+	// An eye is false if the opponent holds enough of its diagonals
+	opponent := 0
+	for _, d := range s.diagonals[pt] {
+		if p.cells[d] == color*-1 {
+			opponent++
+		}
+	}
+
+	if len(s.diagonals[pt]) < 4 {
+		return opponent == 0
+	}
+	return opponent < 2
+}
+
+// winner scores a finished playout by area, counting empty points surrounded by a single color.
+func (s *searcher) winner(p *playout) int8 {
+	score := -s.komi
+	for pt, c := range p.cells {
+		if c == Empty {
+			c = s.owner(p, pt)
+		}
+
+		switch c {
+		case Black:
+			score++
+		case White:
+			score--
+		}
+	}
+
+	if score > 0 {
+		return Black
+	}
+	return White
+}
+
+// owner returns the color of the stones bordering an empty point, or Empty if both colors touch it.
+// Random games end with only small gaps left, so the direct neighbours are enough.
+func (s *searcher) owner(p *playout, pt int) int8 {
+	var owner int8
+	for _, n := range s.neighbors[pt] {
+		c := p.cells[n]
+		switch {
+		case c == Empty:
+			continue
+		case owner == Empty:
+			owner = c
+		case owner != c:
+			return Empty
+		}
+	}
+	return owner
+}
@@ -1,7 +1,12 @@
 package gogame
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+
+	"ascii-arcade/pkg/registry"
 
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -12,6 +17,7 @@ type GoModel struct {
 	board        Board
 	boardHistory map[string]struct{}
 	boardSize    int
+	komi         float64
 	lastMove     *Position
 	koPoint      *Position
 	cursor       Position
@@ -19,6 +25,20 @@ type GoModel struct {
 	score        Score
 	message      string
 
+	// Game record, with the move currently on the board
+	record   *gameNode
+	current  *gameNode
+	resigned int8
+	review   *review
+
+	showMoveNumbers bool
+
+	// Estimated owner of every point, for the position at ownershipNode
+	showOwnership    bool
+	ownership        []float64
+	ownershipNode    *gameNode
+	ownershipPending bool
+
 	blackCaptures int
 	whiteCaptures int
 
@@ -30,12 +50,61 @@ type GoModel struct {
 
 	markingDeadStones bool
 	deadStones        map[Position]bool
+
+	// Setup
+	setupRow    int
+	ruleset     int
+	handicap    int
+	vsComputer  bool
+	playerColor int8
+	strength    int
+
+	// Computer opponent
+	thinking  bool
+	searchSeq int
+
+	// Snapshots of earlier positions for undo and redo
+	undoStack []*GoModel
+	redoStack []*GoModel
+}
+
+// Rows of the setup screen.
+const (
+	setupSize = iota
+	setupRules
+	setupKomi
+	setupHandicap
+	setupMode
+	setupSide
+	setupStrength
+	setupStart
+)
+
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Go",
+		Category: registry.Strategy,
+		Order:    1,
+		New:      func() tea.Model { return InitGoModel() },
+		Launchers: []registry.Launcher{
+			{
+				Flag:  "sgf",
+				Usage: "Review the first Go game in an SGF file",
+				Open:  func(path string) (tea.Model, error) { return LoadSGFFile(path) },
+			},
+		},
+	})
 }
 
 // InitGoModel creates and initializes a new Go game model.
 func InitGoModel() *GoModel {
 	return &GoModel{
-		message: "Select board size",
+		message:     "Game settings",
+		boardSize:   BoardSize9,
+		komi:        Rulesets[defaultRuleset].Komi,
+		ruleset:     defaultRuleset,
+		playerColor: Black,
+		strength:    defaultStrength,
 	}
 }
 
@@ -44,6 +113,7 @@ func createGame(size int) *GoModel {
 	m := &GoModel{
 		board:        NewBoard(size),
 		boardSize:    size,
+		komi:         Rulesets[defaultRuleset].Komi,
 		lastMove:     nil,
 		turn:         Black,
 		showLabels:   true,
@@ -54,6 +124,8 @@ func createGame(size int) *GoModel {
 	}
 
 	m.boardHistory[fmt.Sprint(m.board.Cells)] = struct{}{}
+	m.record = &gameNode{color: Black}
+	m.current = m.record
 
 	return m
 }
@@ -63,9 +135,24 @@ func (m *GoModel) Init() tea.Cmd {
 	return nil
 }
 
-// Update handles keypress and mouse events.
+// Update handles keypress and mouse events, then refreshes the ownership map if the position changed.
 func (m *GoModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := m.update(msg)
+	if next, ok := model.(*GoModel); ok {
+		return next, tea.Batch(cmd, next.startOwnershipEstimate())
+	}
+	return model, cmd
+}
+
+// update dispatches a message to its handler.
+func (m *GoModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case aiMoveMsg:
+		return m.handleComputerMove(msg)
+	case ownershipMsg:
+		return m.handleOwnership(msg)
+	case deadStonesMsg:
+		return m.handleDeadStones(msg)
 	case tea.KeyPressMsg:
 		return m.handleKeyPress(msg)
 	case tea.MouseClickMsg:
@@ -82,13 +169,13 @@ func (m *GoModel) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	// Always allow resetting and changing game
 	switch key {
 	case "ctrl+r":
-		return handleReset(m.boardSize), nil
+		return m.handleReset(m.boardSize), nil
 	case "1":
-		return handleReset(BoardSize9), nil
+		return m.handleReset(BoardSize9), nil
 	case "2":
-		return handleReset(BoardSize13), nil
+		return m.handleReset(BoardSize13), nil
 	case "3":
-		return handleReset(BoardSize19), nil
+		return m.handleReset(BoardSize19), nil
 	}
 
 	// Allow enter to confirm score when marking dead stones
@@ -96,20 +183,63 @@ func (m *GoModel) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m.handleConfirmScore()
 	}
 
+	if m.review != nil {
+		return m.handleReviewKey(key)
+	}
+
+	// Moves can be taken back after the game ends and while marking dead stones
+	if m.hasSelected {
+		switch key {
+		case "u", "ctrl+z":
+			return m, m.handleUndo()
+		case "U", "ctrl+shift+z":
+			return m, m.handleRedo()
+		}
+	}
+
+	// Saving and reviewing stay available after the game ends
+	if m.hasSelected && !m.markingDeadStones {
+		switch key {
+		case "ctrl+e":
+			if path, err := m.saveGame(); err != nil {
+				m.message = err.Error()
+			} else {
+				m.message = "Game saved to " + path + "."
+			}
+			return m, nil
+		case "v":
+			return m.startReview()
+		case "[":
+			// Browse back from the current position
+			model, cmd := m.startReview()
+			if m.review != nil {
+				m.review.index = max(m.review.index-1, 0)
+			}
+			return model, cmd
+		case "n":
+			m.showMoveNumbers = !m.showMoveNumbers
+			return m, nil
+		}
+	}
+
 	// Disable all other keypresses on game over screen
 	if m.gameOver {
 		return m, nil
 	}
 
-	// Difficulty selection menu
+	// Game settings menu
 	if !m.hasSelected {
 		switch key {
 		case "up", "w":
-			m.cursor.Y = (m.cursor.Y - 1 + len(BoardSizes)) % len(BoardSizes)
-		case "down", "s":
-			m.cursor.Y = (m.cursor.Y + 1) % len(BoardSizes)
+			m.setupRow = m.prevSetupRow()
+		case "down", "s", "tab":
+			m.setupRow = m.nextSetupRow()
+		case "left", "a":
+			m.changeSetting(m.setupRow, -1)
+		case "right", "d", "space":
+			m.changeSetting(m.setupRow, 1)
 		case "enter":
-			return createGame(BoardSizes[m.cursor.Y]), nil
+			return m.startGame()
 		}
 		return m, nil
 	}
@@ -132,13 +262,21 @@ func (m *GoModel) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			m.cursor.X++
 		}
 	case "space", "enter":
+		if m.isComputerTurn() {
+			return m, nil
+		}
 		return m.handlePlaceStone(m.cursor)
 	case "p":
+		if m.isComputerTurn() {
+			return m, nil
+		}
 		return m.handlePass()
 	case "r":
 		return m.handleResign()
 	case "l":
 		m.showLabels = !m.showLabels
+	case "o":
+		m.showOwnership = !m.showOwnership
 	}
 
 	return m, nil
@@ -151,6 +289,31 @@ func (m *GoModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Handle the setup screen
+	if !m.hasSelected {
+		for row := setupSize; row <= setupStart; row++ {
+			if !zone.Get(fmt.Sprintf("setup_%d", row)).InBounds(msg) {
+				continue
+			}
+			if row == setupStart {
+				return m.startGame()
+			}
+			m.setupRow = row
+			m.changeSetting(row, 1)
+		}
+		return m, nil
+	}
+
+	// The board is read only while reviewing a game, but the scrubber jumps between moves
+	if m.review != nil {
+		for col := range m.review.scrubberWidth() {
+			if zone.Get(fmt.Sprintf("scrub_%d", col)).InBounds(msg) {
+				m.review.index = m.review.scrubberIndex(col)
+			}
+		}
+		return m, nil
+	}
+
 	// Handle dead stone marking phase
 	if m.gameOver && m.markingDeadStones {
 		// Check if score button was clicked
@@ -178,7 +341,7 @@ func (m *GoModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	if m.gameOver {
 		switch {
 		case zone.Get("reset").InBounds(msg):
-			return InitGoModel(), nil
+			return m.handleReset(m.boardSize), nil
 		case zone.Get("exit").InBounds(msg):
 			return m, func() tea.Msg { return "home" }
 		default:
@@ -186,6 +349,14 @@ func (m *GoModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Ignore the board while the computer is moving
+	if m.isComputerTurn() {
+		if zone.Get("resign").InBounds(msg) {
+			return m.handleResign()
+		}
+		return m, nil
+	}
+
 	// Check pass button
 	if zone.Get("pass").InBounds(msg) {
 		return m.handlePass()
@@ -214,40 +385,17 @@ func (m *GoModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 // handlePlaceStone attempts to place a stone at the given position.
 func (m *GoModel) handlePlaceStone(pos Position) (tea.Model, tea.Cmd) {
-	// Cannot place on occupied position
-	if m.board.Cells[pos.Y][pos.X] != Empty {
-		m.message = "That intersection is already occupied."
-		return m, nil
-	}
-
-	// Simulate the placement to check captures
-	boardCopy := m.board.Clone()
-	boardCopy.PlaceStone(pos, m.turn)
-
-	// Check for suicide
-	if boardCopy.GetGroup(pos).Liberties == 0 {
-		m.message = fmt.Sprintf(
-			"Suicide: stone at %s would have no liberties.",
-			formatPosition(pos, m.boardSize),
-		)
-		return m, nil
-	}
-
-	// Check simple ko
-	if m.koPoint != nil && m.koPoint.X == pos.X && m.koPoint.Y == pos.Y {
-		m.message = "Ko: That move would recapture the ko immediately."
+	if err := m.checkMove(pos); err != nil {
+		m.message = err.Error()
 		return m, nil
 	}
 
-	// Check superko: no repetition of any previous board position
-	if _, exists := m.boardHistory[fmt.Sprint(boardCopy.Cells)]; exists {
-		m.message = "Ko: That move would repeat a previous board position (superko)."
-		return m, nil
-	}
+	m.pushUndo()
 
 	// Apply the move to the real board
 	captured := m.board.PlaceStone(pos, m.turn)
 	m.lastMove = &Position{X: pos.X, Y: pos.Y}
+	m.recordMove(m.turn, m.lastMove)
 	m.boardHistory[fmt.Sprint(m.board.Cells)] = struct{}{}
 
 	if len(captured) > 0 {
@@ -277,11 +425,46 @@ func (m *GoModel) handlePlaceStone(pos Position) (tea.Model, tea.Cmd) {
 	m.whitePassed = false
 	m.turn = m.turn * -1
 
-	return m, nil
+	return m, m.startComputerTurn()
+}
+
+// checkMove returns an error explaining why a stone cannot be placed at the given position.
+func (m *GoModel) checkMove(pos Position) error {
+	// Cannot place on occupied position
+	if m.board.Cells[pos.Y][pos.X] != Empty {
+		return errors.New("That intersection is already occupied.")
+	}
+
+	// Simulate the placement to check captures
+	boardCopy := m.board.Clone()
+	boardCopy.PlaceStone(pos, m.turn)
+
+	// Check for suicide
+	if boardCopy.GetGroup(pos).Liberties == 0 {
+		return fmt.Errorf(
+			"Suicide: stone at %s would have no liberties.",
+			formatPosition(pos, m.boardSize),
+		)
+	}
+
+	// Check simple ko
+	if m.koPoint != nil && m.koPoint.X == pos.X && m.koPoint.Y == pos.Y {
+		return errors.New("Ko: That move would recapture the ko immediately.")
+	}
+
+	// Check superko: no repetition of any previous board position
+	if _, exists := m.boardHistory[fmt.Sprint(boardCopy.Cells)]; exists {
+		return errors.New("Ko: That move would repeat a previous board position (superko).")
+	}
+
+	return nil
 }
 
 // handlePass records a pass for the current player.
 func (m *GoModel) handlePass() (tea.Model, tea.Cmd) {
+	m.pushUndo()
+	m.recordMove(m.turn, nil)
+
 	if m.turn == Black {
 		m.blackPassed = true
 	} else {
@@ -301,25 +484,36 @@ func (m *GoModel) handlePass() (tea.Model, tea.Cmd) {
 	if m.blackPassed && m.whitePassed {
 		m.gameOver = true
 		if m.board.HasStones() {
-			// Enter dead stone marking phase
+			// Enter dead stone marking phase once the estimate is ready
 			m.markingDeadStones = true
-			m.deadStones = make(map[Position]bool)
-			m.message = "Mark dead stones by clicking them, then press Enter or [Score] to finish."
-		} else {
-			// Empty board, calculate score directly
-			m.score = CalculateScore(m.board)
+			m.deadStones = nil
+			m.message = "Both players passed, counting…"
+			return m, m.startDeadStoneEstimate()
 		}
+
+		// Empty board, calculate score directly
+		m.score = m.calculateScore()
 		return m, nil
 	}
 
 	m.turn = m.turn * -1
-	return m, nil
+	return m, m.startComputerTurn()
 }
 
 // handleResign handles a player resigning.
 func (m *GoModel) handleResign() (tea.Model, tea.Cmd) {
-	winner := m.turn * -1
+	m.pushUndo()
+
+	// Against the computer it is always the player who resigns
+	loser := m.turn
+	if m.vsComputer {
+		loser = m.playerColor
+	}
+
+	winner := loser * -1
+	m.resigned = loser
 	m.gameOver = true
+	m.thinking = false
 
 	// The non-resigning player gets the maximum score
 	maxScore := float64(m.boardSize * m.boardSize)
@@ -328,7 +522,7 @@ func (m *GoModel) handleResign() (tea.Model, tea.Cmd) {
 		m.score.WhiteScore = 0
 	} else {
 		m.score.BlackScore = 0
-		m.score.WhiteScore = maxScore + Komi
+		m.score.WhiteScore = maxScore + m.komi
 	}
 	m.score.Winner = winner
 
@@ -337,6 +531,11 @@ func (m *GoModel) handleResign() (tea.Model, tea.Cmd) {
 
 // handleConfirmScore removes dead stones and calculates the final score.
 func (m *GoModel) handleConfirmScore() (tea.Model, tea.Cmd) {
+	// Wait until the dead stones have been estimated
+	if m.deadStones == nil {
+		return m, nil
+	}
+
 	// Remove dead stones from the board and count them
 	removedBlack := 0
 	removedWhite := 0
@@ -355,7 +554,7 @@ func (m *GoModel) handleConfirmScore() (tea.Model, tea.Cmd) {
 	m.whiteCaptures += removedBlack
 
 	// Calculate final score with dead stones removed
-	m.score = CalculateScore(m.board)
+	m.score = m.calculateScore()
 	m.markingDeadStones = false
 
 	// Show what was removed
@@ -375,7 +574,7 @@ func (m *GoModel) handleConfirmScore() (tea.Model, tea.Cmd) {
 
 // handleDeadStoneMarking toggles the alive/dead status of a stone.
 func (m *GoModel) handleDeadStoneMarking(pos Position) (tea.Model, tea.Cmd) {
-	if m.board.Cells[pos.Y][pos.X] != Empty {
+	if m.deadStones != nil && m.board.Cells[pos.Y][pos.X] != Empty {
 		if m.deadStones[pos] {
 			// Mark the stone as alive
 			delete(m.deadStones, pos)
@@ -398,17 +597,148 @@ func (m *GoModel) handleDeadStoneMarking(pos Position) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handleReset resets the game to its initial state.
-func handleReset(boardSize int) *GoModel {
+// handleReset returns to the game settings, keeping the current settings.
+func (m *GoModel) handleReset(boardSize int) *GoModel {
 	newModel := InitGoModel()
 	newModel.boardSize = boardSize
 	newModel.board = NewBoard(boardSize)
 	newModel.boardHistory = make(map[string]struct{})
 	newModel.boardHistory[fmt.Sprint(newModel.board.Cells)] = struct{}{}
 	newModel.cursor = Position{X: boardSize / 2, Y: boardSize / 2}
+	newModel.ruleset = m.ruleset
+	newModel.komi = m.komi
+	newModel.handicap = m.handicap
+	newModel.vsComputer = m.vsComputer
+	newModel.playerColor = m.playerColor
+	newModel.strength = m.strength
+	newModel.searchSeq = m.searchSeq + 1
 	return newModel
 }
 
+// startGame begins a game with the chosen settings.
+func (m *GoModel) startGame() (tea.Model, tea.Cmd) {
+	next := createGame(m.boardSize)
+	next.ruleset = m.ruleset
+	next.komi = m.komi
+	next.handicap = m.handicap
+
+	// Handicap stones go on the star points and White moves first
+	if m.handicap > 0 {
+		next.record.addBlack = handicapPoints(m.boardSize, m.handicap)
+		next.record.color = White
+		next.applySetup()
+	}
+
+	next.vsComputer = m.vsComputer
+	next.playerColor = m.playerColor
+	next.strength = m.strength
+	next.searchSeq = m.searchSeq + 1
+	return next, next.startComputerTurn()
+}
+
+// handleComputerMove plays the move chosen by the computer opponent.
+func (m *GoModel) handleComputerMove(msg aiMoveMsg) (tea.Model, tea.Cmd) {
+	// Drop results from searches that belong to an earlier game
+	if msg.seq != m.searchSeq || !m.isComputerTurn() {
+		return m, nil
+	}
+
+	m.thinking = false
+	if msg.move == nil {
+		return m.handlePass()
+	}
+
+	player := colorName(m.turn)
+	model, cmd := m.handlePlaceStone(*msg.move)
+	if m.message == "" {
+		m.message = fmt.Sprintf("%s plays %s.", player, formatPosition(*msg.move, m.boardSize))
+	}
+	return model, cmd
+}
+
+// isComputerTurn reports whether the computer opponent is to move.
+func (m *GoModel) isComputerTurn() bool {
+	return m.vsComputer && m.hasSelected && !m.gameOver && m.turn != m.playerColor
+}
+
+// startComputerTurn returns a command that searches for the computer's move off the UI goroutine.
+func (m *GoModel) startComputerTurn() tea.Cmd {
+	if !m.isComputerTurn() {
+		return nil
+	}
+
+	m.thinking = true
+	seq := m.searchSeq
+	position := m.clone()
+	playouts := Strengths[m.strength].Playouts[m.boardSize]
+
+	return func() tea.Msg {
+		return aiMoveMsg{seq: seq, move: bestMove(position, playouts)}
+	}
+}
+
+// changeSetting cycles the value of a setup row in the given direction.
+func (m *GoModel) changeSetting(row, delta int) {
+	switch row {
+	case setupSize:
+		i := slices.Index(BoardSizes, m.boardSize)
+		m.boardSize = BoardSizes[(i+delta+len(BoardSizes))%len(BoardSizes)]
+	case setupRules:
+		m.ruleset = (m.ruleset + delta + len(Rulesets)) % len(Rulesets)
+		m.komi = m.defaultKomi()
+	case setupKomi:
+		m.komi = min(max(m.komi+float64(delta)*komiStep, -maxKomi), maxKomi)
+	case setupHandicap:
+		// There is no one stone handicap, so the count jumps between none and two
+		switch {
+		case m.handicap == 0 && delta > 0:
+			m.handicap = 2
+		case m.handicap == 2 && delta < 0:
+			m.handicap = 0
+		case m.handicap > 0:
+			m.handicap = min(m.handicap+delta, MaxHandicap)
+		}
+		m.komi = m.defaultKomi()
+	case setupMode:
+		m.vsComputer = !m.vsComputer
+	case setupSide:
+		m.playerColor *= -1
+	case setupStrength:
+		m.strength = min(max(m.strength+delta, 0), len(Strengths)-1)
+	}
+}
+
+// nextSetupRow moves down the setup screen, skipping computer options in two player mode.
+func (m *GoModel) nextSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupMode {
+		return setupStart
+	}
+	return min(m.setupRow+1, setupStart)
+}
+
+// prevSetupRow moves up the setup screen, skipping computer options in two player mode.
+func (m *GoModel) prevSetupRow() int {
+	if !m.vsComputer && m.setupRow == setupStart {
+		return setupMode
+	}
+	return max(m.setupRow-1, setupSize)
+}
+
+// clone returns a copy of the game state for the computer's search, without the undo history.
+func (m *GoModel) clone() *GoModel {
+	c := *m
+	c.undoStack = nil
+	c.redoStack = nil
+	c.board = m.board.Clone()
+	c.boardHistory = maps.Clone(m.boardHistory)
+	c.deadStones = maps.Clone(m.deadStones)
+	if m.koPoint != nil {
+		ko := *m.koPoint
+		c.koPoint = &ko
+	}
+	return &c
+}
+
 // colorName returns the name of a color constant.
 func colorName(color int8) string {
 	switch color {
@@ -23,16 +23,26 @@ players take turns placing stones on a grid.
 • Place stones to surround territory and capture opponent's stones.
 • A group of stones is captured when it has no liberties
   (adjacent empty intersections).
-• The player with the most area (territory surrounded by their
-  stones + their remaining stones on the board) wins.
+• The player with the most points wins, counted by area or
+  territory depending on the rules picked in the settings.
 • A stone cannot be placed where it would be captured
-  immediately (suicide), unless it captures opponent stones.`
+  immediately (suicide), unless it captures opponent stones.
 
-	ScoringInfo = `• Area scoring is used: Territory + Stones on board.
-• White receives 7.5 komi (compensation for going second).
+Play against a friend on the same keyboard or against the
+computer, which picks its moves by playing out thousands of
+random games. Pick your side and its strength from the
+settings screen.`
+
+	ScoringInfo = `• Chinese / AGA rules use area scoring: Territory + Stones.
+• Japanese rules use territory scoring: Territory + Prisoners.
+• White receives komi for going second: 7.5 under area and
+  6.5 under territory scoring, adjustable in the settings.
+• Handicap stones go on the star points and White moves
+  first; komi drops to 0.5 to break ties.
 • The game ends when both players pass consecutively.
-• After the game ends, click stones to mark them as dead,
-  then press Score to remove them and calculate the final score.
+• After the game ends, dead stones are estimated and marked;
+  click stones to adjust, then press Score to remove them and
+  calculate the final score.
 • Dead stones no longer count toward either score.`
 )
 
@@ -52,6 +62,13 @@ func (m *GoModel) Help() string {
 		{Key: "space / enter", Action: "place stone"},
 		{Key: "click", Action: "place stone"},
 		{Key: "1 / 2 / 3", Action: "board size"},
+		{Key: "ctrl+r", Action: "game settings"},
+		{Key: "ctrl+e", Action: "save game as SGF"},
+		{Key: "v", Action: "review game"},
+		{Key: "← / →", Action: "step through review"},
+		{Key: "[ / ]", Action: "browse history"},
+		{Key: "u / ctrl+z", Action: "undo move"},
+		{Key: "U / ctrl+shift+z", Action: "redo move"},
 	}
 
 	gameKeybinds := []components.Keybind{
@@ -62,6 +79,8 @@ func (m *GoModel) Help() string {
 		{Key: "r", Action: "resign"},
 		{Key: "l", Action: "labels"},
 		{Key: "p", Action: "pass"},
+		{Key: "n", Action: "move numbers"},
+		{Key: "o", Action: "ownership map"},
 	}
 
 	game := components.ViewKeybinds("Game Keybinds", gameKeybinds)
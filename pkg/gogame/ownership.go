@@ -0,0 +1,316 @@
+package gogame
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// Random games played to estimate who owns each point, for each board size
+var (
+	ownershipPlayouts = map[int]int{BoardSize9: 300, BoardSize13: 150, BoardSize19: 80}
+	deadStonePlayouts = map[int]int{BoardSize9: 1000, BoardSize13: 600, BoardSize19: 400}
+)
+
+// ownershipThreshold is how one-sided the playouts must be before a point is shown as owned.
+const ownershipThreshold = 0.5
+
+// deadThreshold is how strongly the playouts must hand a group to the opponent before it is marked dead.
+const deadThreshold = 0.3
+
+// ownershipMsg carries the ownership estimate for the position at node.
+type ownershipMsg struct {
+	seq       int
+	node      *gameNode
+	ownership []float64
+}
+
+// deadStonesMsg carries the dead stones estimated once both players have passed.
+type deadStonesMsg struct {
+	seq  int
+	dead map[Position]bool
+}
+
+// estimateOwnership plays random games from the position and returns the average owner of every
+// point at the end, from -1 for Black to 1 for White. Stones that Benson's algorithm proves alive,
+// and the eyes they enclose, are always given to their owner.
+func estimateOwnership(m *GoModel, playouts int) []float64 {
+	s := newSearcher(m.boardSize)
+	s.komi = m.komi
+	start := s.fromModel(m)
+
+	ownership := make([]float64, len(start.cells))
+	for range playouts {
+		p := start.clone()
+		s.simulate(p)
+
+		for pt, c := range p.cells {
+			if c == Empty {
+				c = s.owner(p, pt)
+			}
+			ownership[pt] += float64(c)
+		}
+	}
+
+	for pt := range ownership {
+		ownership[pt] /= float64(playouts)
+	}
+
+	for _, color := range []int8{Black, White} {
+		for pt, safe := range s.unconditionallyAlive(start, color) {
+			if safe {
+				ownership[pt] = float64(color)
+			}
+		}
+	}
+
+	return ownership
+}
+
+// estimateDeadStones marks every group whose points the random games mostly hand to the opponent.
+func (m *GoModel) estimateDeadStones() map[Position]bool {
+	ownership := estimateOwnership(m, deadStonePlayouts[m.boardSize])
+	dead := make(map[Position]bool)
+	visited := make(map[Position]bool)
+
+	for y := range m.boardSize {
+		for x := range m.boardSize {
+			pos := Position{X: x, Y: y}
+			color := m.board.Cells[y][x]
+			if color == Empty || visited[pos] {
+				continue
+			}
+
+			// Judge whole groups so a chain is never split between alive and dead
+			group := m.board.GetGroup(pos)
+			total := 0.0
+			for _, stone := range group.Stones {
+				visited[stone] = true
+				total += ownership[stone.Y*m.boardSize+stone.X] * float64(color)
+			}
+
+			if total/float64(len(group.Stones)) < -deadThreshold {
+				for _, stone := range group.Stones {
+					dead[stone] = true
+				}
+			}
+		}
+	}
+
+	return dead
+}
+
+// startOwnershipEstimate returns a command that estimates the ownership map off the UI goroutine
+// when the overlay is shown and the position has changed since the last estimate.
+func (m *GoModel) startOwnershipEstimate() tea.Cmd {
+	if !m.showOwnership || !m.hasSelected || m.markingDeadStones || m.review != nil {
+		return nil
+	}
+	if m.ownershipNode == m.current && (m.ownership != nil || m.ownershipPending) {
+		return nil
+	}
+
+	m.ownershipNode = m.current
+	m.ownershipPending = true
+	seq, node := m.searchSeq, m.current
+	position := m.clone()
+	playouts := ownershipPlayouts[m.boardSize]
+
+	return func() tea.Msg {
+		return ownershipMsg{seq: seq, node: node, ownership: estimateOwnership(position, playouts)}
+	}
+}
+
+// handleOwnership stores an ownership estimate unless the position has moved on since it was requested.
+func (m *GoModel) handleOwnership(msg ownershipMsg) (tea.Model, tea.Cmd) {
+	if msg.node != m.ownershipNode {
+		return m, nil
+	}
+
+	m.ownershipPending = false
+	if msg.seq != m.searchSeq {
+		// Ask again for the position now on the board
+		m.ownership = nil
+		return m, nil
+	}
+
+	m.ownership = msg.ownership
+	return m, nil
+}
+
+// startDeadStoneEstimate returns a command that estimates the dead stones off the UI goroutine.
+func (m *GoModel) startDeadStoneEstimate() tea.Cmd {
+	seq := m.searchSeq
+	position := m.clone()
+
+	return func() tea.Msg {
+		return deadStonesMsg{seq: seq, dead: position.estimateDeadStones()}
+	}
+}
+
+// handleDeadStones marks the estimated dead stones for the player to adjust.
+func (m *GoModel) handleDeadStones(msg deadStonesMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != m.searchSeq || !m.markingDeadStones || m.deadStones != nil {
+		return m, nil
+	}
+
+	m.deadStones = msg.dead
+	m.message = fmt.Sprintf(
+		"Estimated %d dead stone(s). Click stones to adjust, then press Enter or [Score] to finish.",
+		len(m.deadStones),
+	)
+	return m, nil
+}
+
+// clone returns a copy of a playout board.
+func (p *playout) clone() *playout {
+	c := *p
+	c.cells = make([]int8, len(p.cells))
+	copy(c.cells, p.cells)
+	return &c
+}
+
+// unconditionallyAlive runs Benson's algorithm for one color. It reports the stones that
+// could never be captured even if their owner always passed, along with the regions they
+// enclose as eyes.
+func (s *searcher) unconditionallyAlive(p *playout, color int8) []bool {
+	// Label the chains of the color and the regions of every other point
+	chainOf := make([]int, len(p.cells))
+	regionOf := make([]int, len(p.cells))
+	for pt := range p.cells {
+		chainOf[pt], regionOf[pt] = -1, -1
+	}
+
+	var chains, regions [][]int
+	for pt, c := range p.cells {
+		if c == color && chainOf[pt] == -1 {
+			chains = append(chains, s.flood(p, pt, chainOf, len(chains), func(c int8) bool { return c == color }))
+		} else if c != color && regionOf[pt] == -1 {
+			regions = append(regions, s.flood(p, pt, regionOf, len(regions), func(c int8) bool { return c != color }))
+		}
+	}
+
+	// A region is vital to a bordering chain if every empty point in it is a liberty of that chain
+	borders := make([]map[int]bool, len(regions))
+	vital := make([][]int, len(chains))
+	for r, points := range regions {
+		borders[r] = make(map[int]bool)
+		for _, pt := range points {
+			for _, n := range s.neighbors[pt] {
+				if chainOf[n] >= 0 {
+					borders[r][chainOf[n]] = true
+				}
+			}
+		}
+
+		for chain := range borders[r] {
+			if s.isVitalRegion(p, points, chainOf, chain) {
+				vital[chain] = append(vital[chain], r)
+			}
+		}
+	}
+
+	// Drop chains with fewer than two vital regions and regions bordered by a dropped chain until nothing changes
+	aliveChain := make([]bool, len(chains))
+	aliveRegion := make([]bool, len(regions))
+	for i := range aliveChain {
+		aliveChain[i] = true
+	}
+	for i := range aliveRegion {
+		aliveRegion[i] = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for chain := range chains {
+			if !aliveChain[chain] {
+				continue
+			}
+
+			count := 0
+			for _, r := range vital[chain] {
+				if aliveRegion[r] {
+					count++
+				}
+			}
+			if count < 2 {
+				aliveChain[chain] = false
+				changed = true
+			}
+		}
+
+		for r := range regions {
+			if !aliveRegion[r] {
+				continue
+			}
+
+			for chain := range borders[r] {
+				if !aliveChain[chain] {
+					aliveRegion[r] = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	safe := make([]bool, len(p.cells))
+	for chain, points := range chains {
+		if !aliveChain[chain] {
+			continue
+		}
+
+		for _, pt := range points {
+			safe[pt] = true
+		}
+		for _, r := range vital[chain] {
+			if aliveRegion[r] {
+				for _, pt := range regions[r] {
+					safe[pt] = true
+				}
+			}
+		}
+	}
+
+	return safe
+}
+
+// flood labels the connected points that match a predicate, starting from pt, and returns them.
+func (s *searcher) flood(p *playout, pt int, labels []int, label int, match func(int8) bool) []int {
+	points := []int{pt}
+	labels[pt] = label
+
+	for i := 0; i < len(points); i++ {
+		for _, n := range s.neighbors[points[i]] {
+			if labels[n] == -1 && match(p.cells[n]) {
+				labels[n] = label
+				points = append(points, n)
+			}
+		}
+	}
+
+	return points
+}
+
+// isVitalRegion reports whether every empty point of a region is a liberty of the given chain.
+func (s *searcher) isVitalRegion(p *playout, region, chainOf []int, chain int) bool {
+	for _, pt := range region {
+		if p.cells[pt] != Empty {
+			continue
+		}
+
+		liberty := false
+		for _, n := range s.neighbors[pt] {
+			if chainOf[n] == chain {
+				liberty = true
+				break
+			}
+		}
+		if !liberty {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package gogame
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Ruleset describes how a finished game is counted.
+type Ruleset struct {
+	Name string
+
+	// SGF is the RU value written to saved games
+	SGF string
+
+	// Territory counts surrounded points plus prisoners instead of surrounded points plus stones on the board
+	Territory bool
+
+	// Komi is the usual compensation given to White under these rules
+	Komi float64
+}
+
+// Rulesets lists the scoring rules offered on the setup screen.
+var Rulesets = []Ruleset{
+	{Name: "Chinese / AGA (area)", SGF: "Chinese", Komi: 7.5},
+	{Name: "Japanese (territory)", SGF: "Japanese", Territory: true, Komi: 6.5},
+}
+
+const defaultRuleset = 0
+
+// Komi limits and step on the setup screen
+const (
+	komiStep = 0.5
+	maxKomi  = 15.0
+)
+
+// HandicapKomi only breaks ties, as the handicap stones make up for moving second.
+const HandicapKomi = 0.5
+
+// MaxHandicap is the largest number of handicap stones that fit on the star points.
+const MaxHandicap = 9
+
+// handicapPoints returns the star points for the given number of handicap stones, in the
+// traditional order: opposite corners first, then the remaining corners, sides and centre.
+func handicapPoints(size, stones int) []Position {
+	if stones < 2 {
+		return nil
+	}
+
+	// Star points sit on the third line on 9x9 and the fourth line on larger boards
+	edge := 3
+	if size < BoardSize13 {
+		edge = 2
+	}
+	far := size - 1 - edge
+	mid := size / 2
+
+	corners := []Position{{far, edge}, {edge, far}, {far, far}, {edge, edge}}
+	if stones <= len(corners) {
+		return corners[:stones]
+	}
+
+	points := slices.Clone(corners)
+	if stones >= 6 {
+		points = append(points, Position{edge, mid}, Position{far, mid})
+	}
+	if stones >= 8 {
+		points = append(points, Position{mid, edge}, Position{mid, far})
+	}
+
+	// Odd numbers of stones take the centre point
+	if stones%2 == 1 {
+		points = append(points, Position{mid, mid})
+	}
+
+	return points
+}
+
+// rulesetFromSGF finds the rules for an SGF RU value, falling back to area scoring.
+func rulesetFromSGF(value string) int {
+	// Korean rules count territory the same way as Japanese rules
+	if strings.EqualFold(value, "Korean") || strings.EqualFold(value, "JP") {
+		value = "Japanese"
+	}
+
+	for i, rules := range Rulesets {
+		if strings.EqualFold(rules.SGF, value) {
+			return i
+		}
+	}
+	return defaultRuleset
+}
+
+// defaultKomi returns the komi that suits the chosen rules and handicap.
+func (m *GoModel) defaultKomi() float64 {
+	if m.handicap > 0 {
+		return HandicapKomi
+	}
+	return Rulesets[m.ruleset].Komi
+}
+
+// calculateScore counts a finished game under the chosen rules.
+func (m *GoModel) calculateScore() Score {
+	if Rulesets[m.ruleset].Territory {
+		return CalculateTerritoryScore(m.board, m.komi, m.blackCaptures, m.whiteCaptures)
+	}
+	return CalculateScore(m.board, m.komi)
+}
+
+// formatKomi prints komi without trailing zeros.
+func formatKomi(komi float64) string {
+	return strconv.FormatFloat(komi, 'f', -1, 64)
+}
@@ -1,8 +1,5 @@
 package gogame
 
-// Komi is the compensation points given to White for going second.
-const Komi = 7.5
-
 // Score holds the result of a completed game.
 type Score struct {
 	BlackScore float64
@@ -12,7 +9,7 @@ type Score struct {
 
 // CalculateScore computes the area score for both players.
 // Area scoring = territory + stones on board.
-func CalculateScore(board Board) Score {
+func CalculateScore(board Board, komi float64) Score {
 	territoryBlack, territoryWhite := countTerritory(board)
 
 	// Count stones on the board
@@ -30,8 +27,24 @@ func CalculateScore(board Board) Score {
 	}
 
 	blackScore := float64(territoryBlack + stonesBlack)
-	whiteScore := float64(territoryWhite+stonesWhite) + Komi
+	whiteScore := float64(territoryWhite+stonesWhite) + komi
+
+	return newScore(blackScore, whiteScore)
+}
+
+// CalculateTerritoryScore computes the territory score for both players.
+// Territory scoring = territory + prisoners taken, including dead stones.
+func CalculateTerritoryScore(board Board, komi float64, blackPrisoners, whitePrisoners int) Score {
+	territoryBlack, territoryWhite := countTerritory(board)
+
+	blackScore := float64(territoryBlack + blackPrisoners)
+	whiteScore := float64(territoryWhite+whitePrisoners) + komi
+
+	return newScore(blackScore, whiteScore)
+}
 
+// newScore decides the winner from both players' points.
+func newScore(blackScore, whiteScore float64) Score {
 	var winner int8 = Empty
 	if blackScore > whiteScore {
 		winner = Black
@@ -0,0 +1,693 @@
+package gogame
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const gamesDir = "data/go"
+
+var sgfEscaper = strings.NewReplacer(`\`, `\\`, `]`, `\]`)
+
+// gameNode is a move in the game record. Playing a different move after going
+// back in the game starts a new variation alongside the moves already played.
+type gameNode struct {
+	// color is the player who made the move, or for the root the player who moves first
+	color int8
+
+	// move is the intersection played, or nil for a pass
+	move *Position
+
+	// Stones placed before the first move, only set on the root
+	addBlack []Position
+	addWhite []Position
+
+	parent   *gameNode
+	children []*gameNode
+}
+
+// review steps through the positions along one line of the game record.
+type review struct {
+	positions []*GoModel
+	line      []*gameNode
+	record    *gameNode
+	index     int
+	result    string
+
+	// live is set when reviewing the game in progress, which can be returned to
+	live bool
+}
+
+// sgfNode is a node of a parsed SGF game tree.
+type sgfNode struct {
+	props    map[string][]string
+	children []*sgfNode
+}
+
+// sgfParser reads SGF text one character at a time.
+type sgfParser struct {
+	text string
+	i    int
+}
+
+// LoadSGFFile opens the first game in an SGF file for review.
+func LoadSGFFile(path string) (*GoModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SGF file: %v", err)
+	}
+	return InitGoModelFromSGF(string(data))
+}
+
+// InitGoModelFromSGF plays through the main line of an SGF game and opens it for review from the start.
+func InitGoModelFromSGF(text string) (*GoModel, error) {
+	root, err := parseSGF(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if gm := root.prop("GM"); gm != "" && gm != "1" {
+		return nil, fmt.Errorf("SGF file is not a Go game (GM[%s])", gm)
+	}
+
+	// The SGF default board size is 19
+	size := BoardSize19
+	if sz := root.prop("SZ"); sz != "" {
+		cols, rows, _ := strings.Cut(sz, ":")
+		if rows != "" && rows != cols {
+			return nil, fmt.Errorf("rectangular boards are not supported: %q", sz)
+		}
+		size, err = strconv.Atoi(cols)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SGF board size: %q", sz)
+		}
+	}
+	if !slices.Contains(BoardSizes, size) {
+		return nil, fmt.Errorf("unsupported board size: %d", size)
+	}
+
+	m := createGame(size)
+	if km := root.prop("KM"); km != "" {
+		m.komi, err = strconv.ParseFloat(km, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SGF komi: %q", km)
+		}
+	}
+	m.ruleset = rulesetFromSGF(root.prop("RU"))
+	m.handicap, _ = strconv.Atoi(root.prop("HA"))
+
+	// Setup stones and the player to move first
+	if m.record.addBlack, err = parsePointList(root.props["AB"], size); err != nil {
+		return nil, err
+	}
+	if m.record.addWhite, err = parsePointList(root.props["AW"], size); err != nil {
+		return nil, err
+	}
+	if root.prop("PL") == "W" {
+		m.record.color = White
+	}
+
+	if err := addSGFMoves(m.record, root, size); err != nil {
+		return nil, err
+	}
+
+	// Review the main line, the first variation at every branch
+	var line []*gameNode
+	for n := m.record; len(n.children) > 0; n = n.children[0] {
+		line = append(line, n.children[0])
+	}
+
+	positions, err := m.replayLine(line)
+	if err != nil {
+		return nil, err
+	}
+
+	start := positions[0].clone()
+	start.record = m.record
+	start.current = m.record
+	start.review = &review{
+		positions: positions,
+		line:      line,
+		record:    m.record,
+		result:    root.prop("RE"),
+	}
+	return start, nil
+}
+
+// addSGFMoves adds the moves in an SGF node and its descendants to the game record.
+func addSGFMoves(parent *gameNode, n *sgfNode, size int) error {
+	var color int8
+	var value []string
+	switch {
+	case n.props["B"] != nil:
+		color, value = Black, n.props["B"]
+	case n.props["W"] != nil:
+		color, value = White, n.props["W"]
+	}
+
+	// Nodes without a move, such as the root or comments, are passed through
+	if color != Empty {
+		move, err := parseMovePoint(value[0], size)
+		if err != nil {
+			return err
+		}
+
+		node := &gameNode{color: color, move: move, parent: parent}
+		parent.children = append(parent.children, node)
+		parent = node
+	} else if parent.parent != nil && (n.props["AB"] != nil || n.props["AW"] != nil) {
+		return errors.New("setup stones after the first move are not supported")
+	}
+
+	for _, child := range n.children {
+		if err := addSGFMoves(parent, child, size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseMovePoint converts an SGF move value to a position, returning nil for a pass.
+func parseMovePoint(value string, size int) (*Position, error) {
+	// Older files write a pass as tt on boards up to 19x19
+	if value == "" || (value == "tt" && size <= 19) {
+		return nil, nil
+	}
+
+	pos, err := parsePoint(value, size)
+	if err != nil {
+		return nil, err
+	}
+	return &pos, nil
+}
+
+// parsePointList converts SGF point values, expanding compressed rectangles such as aa:cc.
+func parsePointList(values []string, size int) ([]Position, error) {
+	var points []Position
+	for _, value := range values {
+		from, to, compressed := strings.Cut(value, ":")
+		if !compressed {
+			to = from
+		}
+
+		a, err := parsePoint(from, size)
+		if err != nil {
+			return nil, err
+		}
+		b, err := parsePoint(to, size)
+		if err != nil {
+			return nil, err
+		}
+
+		for y := min(a.Y, b.Y); y <= max(a.Y, b.Y); y++ {
+			for x := min(a.X, b.X); x <= max(a.X, b.X); x++ {
+				points = append(points, Position{X: x, Y: y})
+			}
+		}
+	}
+	return points, nil
+}
+
+// parsePoint converts a two letter SGF point, counted from the top left corner, to a position.
+func parsePoint(value string, size int) (Position, error) {
+	if len(value) != 2 {
+		return Position{}, fmt.Errorf("invalid SGF point: %q", value)
+	}
+
+	pos := Position{X: int(value[0] - 'a'), Y: int(value[1] - 'a')}
+	if pos.X < 0 || pos.X >= size || pos.Y < 0 || pos.Y >= size {
+		return Position{}, fmt.Errorf("SGF point off the board: %q", value)
+	}
+	return pos, nil
+}
+
+// parseSGF parses the first game tree of an SGF collection and returns its root node.
+func parseSGF(text string) (*sgfNode, error) {
+	p := &sgfParser{text: text}
+
+	p.skipSpace()
+	if p.i >= len(p.text) || p.text[p.i] != '(' {
+		return nil, errors.New("SGF game must start with '('")
+	}
+	p.i++
+
+	return p.parseTree()
+}
+
+// parseTree parses a game tree after its opening parenthesis and returns its first node.
+func (p *sgfParser) parseTree() (*sgfNode, error) {
+	var first, last *sgfNode
+
+	for {
+		p.skipSpace()
+		if p.i >= len(p.text) {
+			return nil, errors.New("unterminated SGF game tree")
+		}
+
+		switch p.text[p.i] {
+		case ';':
+			p.i++
+			node, err := p.parseNode()
+			if err != nil {
+				return nil, err
+			}
+
+			if first == nil {
+				first = node
+			} else {
+				last.children = append(last.children, node)
+			}
+			last = node
+
+		// A nested tree is a variation following the last node
+		case '(':
+			p.i++
+			if last == nil {
+				return nil, errors.New("SGF variation before the first node")
+			}
+
+			child, err := p.parseTree()
+			if err != nil {
+				return nil, err
+			}
+			last.children = append(last.children, child)
+
+		case ')':
+			p.i++
+			if first == nil {
+				return nil, errors.New("empty SGF game tree")
+			}
+			return first, nil
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in SGF", p.text[p.i])
+		}
+	}
+}
+
+// parseNode parses the properties of a node after its semicolon.
+func (p *sgfParser) parseNode() (*sgfNode, error) {
+	node := &sgfNode{props: map[string][]string{}}
+
+	for {
+		p.skipSpace()
+
+		// Older files may spell identifiers with lowercase letters, which are ignored
+		var id strings.Builder
+		for p.i < len(p.text) && isLetter(p.text[p.i]) {
+			if c := p.text[p.i]; c >= 'A' && c <= 'Z' {
+				id.WriteByte(c)
+			}
+			p.i++
+		}
+		if id.Len() == 0 {
+			return node, nil
+		}
+
+		p.skipSpace()
+		if p.i >= len(p.text) || p.text[p.i] != '[' {
+			return nil, fmt.Errorf("SGF property %s has no value", id.String())
+		}
+
+		for p.i < len(p.text) && p.text[p.i] == '[' {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.props[id.String()] = append(node.props[id.String()], value)
+			p.skipSpace()
+		}
+	}
+}
+
+// parseValue parses a bracketed property value, resolving escaped characters.
+func (p *sgfParser) parseValue() (string, error) {
+	var value strings.Builder
+
+	for p.i++; p.i < len(p.text); p.i++ {
+		switch c := p.text[p.i]; c {
+		case ']':
+			p.i++
+			return value.String(), nil
+		case '\\':
+			p.i++
+
+			// An escaped line break is a soft break and is removed
+			if p.i < len(p.text) && p.text[p.i] != '\n' {
+				value.WriteByte(p.text[p.i])
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return "", errors.New("unterminated SGF property value")
+}
+
+// skipSpace moves past any whitespace.
+func (p *sgfParser) skipSpace() {
+	for p.i < len(p.text) && strings.ContainsRune(" \t\r\n", rune(p.text[p.i])) {
+		p.i++
+	}
+}
+
+// isLetter reports whether c is an ASCII letter.
+func isLetter(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
+
+// prop returns the first value of a property, or an empty string if it is not set.
+func (n *sgfNode) prop(id string) string {
+	if len(n.props[id]) == 0 {
+		return ""
+	}
+	return n.props[id][0]
+}
+
+// SGF encodes the game record, including variations, as an FF[4] SGF file.
+func (m *GoModel) SGF() string {
+	black, white := "Player 1", "Player 2"
+	if m.vsComputer {
+		computer := fmt.Sprintf("ascii-arcade (%s)", Strengths[m.strength].Name)
+		black, white = "Player", computer
+		if m.playerColor == White {
+			black, white = computer, "Player"
+		}
+	}
+
+	var b strings.Builder
+	writeProp := func(id string, values ...string) {
+		b.WriteString(id)
+		for _, value := range values {
+			b.WriteString("[" + sgfEscaper.Replace(value) + "]")
+		}
+	}
+
+	b.WriteString("(;")
+	writeProp("FF", "4")
+	writeProp("GM", "1")
+	writeProp("CA", "UTF-8")
+	writeProp("AP", "ascii-arcade")
+	writeProp("SZ", strconv.Itoa(m.boardSize))
+	writeProp("KM", formatKomi(m.komi))
+	writeProp("RU", Rulesets[m.ruleset].SGF)
+	if m.handicap > 0 {
+		writeProp("HA", strconv.Itoa(m.handicap))
+	}
+	writeProp("DT", time.Now().Format("2006-01-02"))
+	writeProp("PB", black)
+	writeProp("PW", white)
+	if result := m.result(); result != "" {
+		writeProp("RE", result)
+	}
+
+	// Setup stones and the player to move first
+	if len(m.record.addBlack) > 0 {
+		writeProp("AB", sgfPoints(m.record.addBlack)...)
+	}
+	if len(m.record.addWhite) > 0 {
+		writeProp("AW", sgfPoints(m.record.addWhite)...)
+	}
+	if m.record.color == White {
+		writeProp("PL", "W")
+	}
+
+	if len(m.record.children) == 1 {
+		b.WriteString("\n")
+	}
+	writeSGFMoves(&b, m.record)
+	b.WriteString(")\n")
+
+	return b.String()
+}
+
+// writeSGFMoves writes the moves following a node, wrapping each variation in parentheses.
+func writeSGFMoves(b *strings.Builder, n *gameNode) {
+	for count := 0; len(n.children) == 1; count++ {
+		n = n.children[0]
+
+		// Keep lines a readable length
+		if count > 0 && count%12 == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(sgfMove(n))
+	}
+
+	for _, child := range n.children {
+		b.WriteString("\n(" + sgfMove(child))
+		writeSGFMoves(b, child)
+		b.WriteString(")")
+	}
+}
+
+// sgfMove encodes a single move node.
+func sgfMove(n *gameNode) string {
+	point := ""
+	if n.move != nil {
+		point = sgfPoint(*n.move)
+	}
+	return ";" + colorLetter(n.color) + "[" + point + "]"
+}
+
+// sgfPoints encodes a list of positions as SGF points.
+func sgfPoints(points []Position) []string {
+	values := make([]string, len(points))
+	for i, p := range points {
+		values[i] = sgfPoint(p)
+	}
+	return values
+}
+
+// sgfPoint encodes a position as two letters counted from the top left corner.
+func sgfPoint(p Position) string {
+	return string([]byte{byte('a' + p.X), byte('a' + p.Y)})
+}
+
+// colorLetter returns the SGF letter for a color.
+func colorLetter(color int8) string {
+	if color == White {
+		return "W"
+	}
+	return "B"
+}
+
+// result returns the SGF result of a finished game, or an empty string while it is in progress.
+func (m *GoModel) result() string {
+	switch {
+	case !m.gameOver || m.markingDeadStones:
+		return ""
+	case m.resigned != Empty:
+		return colorLetter(m.resigned*-1) + "+R"
+	case m.score.Winner == Empty:
+		return "0"
+	}
+
+	margin := math.Abs(m.score.BlackScore - m.score.WhiteScore)
+	return colorLetter(m.score.Winner) + "+" + strconv.FormatFloat(margin, 'f', -1, 64)
+}
+
+// saveGame writes the game record to a new SGF file and returns its path.
+func (m *GoModel) saveGame() (string, error) {
+	if err := os.MkdirAll(gamesDir, 0755); err != nil {
+		return "", fmt.Errorf("error creating data dir: %v", err)
+	}
+
+	path := fmt.Sprintf("%s/game-%s.sgf", gamesDir, time.Now().Format("2006-01-02-150405"))
+	if err := os.WriteFile(path, []byte(m.SGF()), 0644); err != nil {
+		return "", fmt.Errorf("error writing game: %v", err)
+	}
+	return path, nil
+}
+
+// recordMove adds a move to the game record, following an existing variation if the move was played before.
+func (m *GoModel) recordMove(color int8, pos *Position) {
+	for _, child := range m.current.children {
+		if child.color == color && equalMoves(child.move, pos) {
+			m.current = child
+			return
+		}
+	}
+
+	node := &gameNode{color: color, move: pos, parent: m.current}
+	m.current.children = append(m.current.children, node)
+	m.current = node
+}
+
+// equalMoves reports whether two moves are the same intersection or both passes.
+func equalMoves(a, b *Position) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// line returns the moves played from the start of the game to the current position.
+func (m *GoModel) line() []*gameNode {
+	var line []*gameNode
+	for n := m.current; n != m.record; n = n.parent {
+		line = append(line, n)
+	}
+	slices.Reverse(line)
+	return line
+}
+
+// applySetup places the setup stones of the game record and gives the move to the player who starts.
+func (m *GoModel) applySetup() {
+	for _, p := range m.record.addBlack {
+		m.board.Cells[p.Y][p.X] = Black
+	}
+	for _, p := range m.record.addWhite {
+		m.board.Cells[p.Y][p.X] = White
+	}
+
+	m.turn = m.record.color
+	m.boardHistory = map[string]struct{}{fmt.Sprint(m.board.Cells): {}}
+}
+
+// replayLine plays moves from the start of the game and returns the position after each of them.
+func (m *GoModel) replayLine(line []*gameNode) ([]*GoModel, error) {
+	game := createGame(m.boardSize)
+	game.komi = m.komi
+	game.ruleset = m.ruleset
+	game.handicap = m.handicap
+	game.record.color = m.record.color
+	game.record.addBlack = m.record.addBlack
+	game.record.addWhite = m.record.addWhite
+	game.applySetup()
+
+	positions := []*GoModel{game.clone()}
+	for i, n := range line {
+		// Moves carry their own color, so a player may move twice in a row
+		game.turn = n.color
+
+		if n.move == nil {
+			// Passes are recorded directly, as play can carry on after two passes
+			game.recordMove(n.color, nil)
+			if n.color == Black {
+				game.blackPassed = true
+			} else {
+				game.whitePassed = true
+			}
+			game.koPoint = nil
+		} else {
+			if err := game.checkMove(*n.move); err != nil {
+				return nil, fmt.Errorf("move %d (%s): %v", i+1, formatPosition(*n.move, m.boardSize), err)
+			}
+			game.handlePlaceStone(*n.move)
+		}
+
+		game.turn = n.color * -1
+		positions = append(positions, game.clone())
+	}
+
+	return positions, nil
+}
+
+// startReview opens the moves played so far for review, starting at the current position.
+func (m *GoModel) startReview() (tea.Model, tea.Cmd) {
+	if m.thinking {
+		return m, nil
+	}
+
+	line := m.line()
+	positions, err := m.replayLine(line)
+	if err != nil {
+		m.message = err.Error()
+		return m, nil
+	}
+
+	m.review = &review{
+		positions: positions,
+		line:      line,
+		record:    m.record,
+		index:     len(positions) - 1,
+		result:    m.result(),
+		live:      true,
+	}
+	return m, nil
+}
+
+// scrubberWidth returns the number of columns in the review timeline, one per position up to the width of the board.
+func (r *review) scrubberWidth() int {
+	size := r.positions[0].boardSize
+	return min(len(r.positions), 4*size+1)
+}
+
+// scrubberIndex returns the position shown when a column of the timeline is clicked.
+func (r *review) scrubberIndex(col int) int {
+	width := r.scrubberWidth()
+	if width < 2 {
+		return 0
+	}
+	return col * (len(r.positions) - 1) / (width - 1)
+}
+
+// scrubberColumn returns the column of the timeline holding the shown position.
+func (r *review) scrubberColumn() int {
+	last := len(r.positions) - 1
+	if last == 0 {
+		return 0
+	}
+	return (r.index*(r.scrubberWidth()-1) + last/2) / last
+}
+
+// handleReviewKey steps through the reviewed game.
+func (m *GoModel) handleReviewKey(key string) (tea.Model, tea.Cmd) {
+	r := m.review
+	last := len(r.positions) - 1
+
+	switch key {
+	case "left", "a", "[":
+		r.index = max(r.index-1, 0)
+	case "right", "d":
+		r.index = min(r.index+1, last)
+	case "]":
+		// Stepping past the end of the game in progress returns to it
+		if r.live && r.index == last {
+			m.review = nil
+		}
+		r.index = min(r.index+1, last)
+	case "home", "up", "w":
+		r.index = 0
+	case "end", "down", "s":
+		r.index = last
+	case "n":
+		m.showMoveNumbers = !m.showMoveNumbers
+	case "l":
+		m.showLabels = !m.showLabels
+	case "v", "esc":
+		// Return to the game in progress
+		if r.live {
+			m.review = nil
+		}
+	case "enter":
+		// Continue playing from the shown position, keeping the full game record
+		next := r.positions[r.index].clone()
+		next.record = r.record
+		next.current = r.record
+		if r.index > 0 {
+			next.current = r.line[r.index-1]
+		}
+
+		next.vsComputer = m.vsComputer
+		next.playerColor = m.playerColor
+		next.strength = m.strength
+		next.showLabels = m.showLabels
+		next.showMoveNumbers = m.showMoveNumbers
+		next.showOwnership = m.showOwnership
+		next.searchSeq = m.searchSeq + 1
+		next.message = ""
+		next.review = nil
+		return next, next.startComputerTurn()
+	}
+
+	return m, nil
+}
@@ -28,6 +28,9 @@ var (
 	WhiteStoneStyle = lipgloss.NewStyle().Foreground(colors.Light1).Background(colors.Tan)
 	DeadStoneStyle  = lipgloss.NewStyle().Foreground(colors.Red).Background(colors.Tan)
 
+	BlackNumberStyle = lipgloss.NewStyle().Foreground(colors.Light1).Background(colors.Dark1).Bold(true)
+	WhiteNumberStyle = lipgloss.NewStyle().Foreground(colors.Dark1).Background(colors.Light1).Bold(true)
+
 	CursorStyle = lipgloss.NewStyle().Foreground(colors.Blue).Background(colors.Tan)
 
 	MessageStyle = lipgloss.NewStyle().Foreground(colors.Pink).MarginTop(1)
@@ -38,6 +41,8 @@ var (
 
 	SelectedListEntry = lipgloss.NewStyle().Foreground(colors.Pink)
 
+	ListDetail = lipgloss.NewStyle().Foreground(colors.Medium2)
+
 	Border       = lipgloss.NewStyle().Padding(1, 4, 2, 4).Background(colors.Tan)
 	BorderLabels = lipgloss.NewStyle().Padding(1, 4, 1, 2).Background(colors.Tan)
 
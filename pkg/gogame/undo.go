@@ -0,0 +1,84 @@
+package gogame
+
+import tea "charm.land/bubbletea/v2"
+
+// pushUndo stores the current game state before a move and clears the redo history.
+func (m *GoModel) pushUndo() {
+	m.undoStack = append(m.undoStack, m.snapshot())
+	m.redoStack = nil
+}
+
+// handleUndo takes back the last move, or the last full turn against the computer.
+func (m *GoModel) handleUndo() tea.Cmd {
+	if len(m.undoStack) == 0 {
+		m.message = "Nothing to undo."
+		return nil
+	}
+
+	// Against the computer keep going back until it is the player's move again
+	for len(m.undoStack) > 0 {
+		last := len(m.undoStack) - 1
+		prev := m.undoStack[last]
+		m.undoStack = m.undoStack[:last]
+
+		m.redoStack = append(m.redoStack, m.snapshot())
+		m.restore(prev)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// handleRedo replays the last move that was taken back.
+func (m *GoModel) handleRedo() tea.Cmd {
+	if len(m.redoStack) == 0 {
+		m.message = "Nothing to redo."
+		return nil
+	}
+
+	for len(m.redoStack) > 0 {
+		last := len(m.redoStack) - 1
+		next := m.redoStack[last]
+		m.redoStack = m.redoStack[:last]
+
+		m.undoStack = append(m.undoStack, m.snapshot())
+		m.restore(next)
+
+		if !m.isComputerTurn() {
+			break
+		}
+	}
+
+	return m.startComputerTurn()
+}
+
+// snapshot returns a copy of the game state for the undo history.
+func (m *GoModel) snapshot() *GoModel {
+	s := m.clone()
+	s.thinking = false
+	s.ownershipPending = false
+	s.message = ""
+	return s
+}
+
+// restore replaces the game state with a snapshot, keeping the history and display settings.
+// The game record is shared, so the moves taken back stay in it as a variation.
+func (m *GoModel) restore(s *GoModel) {
+	undoStack, redoStack := m.undoStack, m.redoStack
+	searchSeq, cursor := m.searchSeq, m.cursor
+	showLabels, showMoveNumbers, showOwnership := m.showLabels, m.showMoveNumbers, m.showOwnership
+
+	*m = *s.clone()
+	m.undoStack = undoStack
+	m.redoStack = redoStack
+	m.cursor = cursor
+	m.showLabels = showLabels
+	m.showMoveNumbers = showMoveNumbers
+	m.showOwnership = showOwnership
+
+	// Drop any search still running for the position that was left
+	m.searchSeq = searchSeq + 1
+}
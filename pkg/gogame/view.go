@@ -16,23 +16,43 @@ import (
 func (m *GoModel) View() tea.View {
 	if !m.hasSelected {
 		return tea.NewView(m.viewSelection())
+	} else if m.review != nil {
+		return tea.NewView(m.viewReview())
 	} else if m.gameOver && !m.markingDeadStones {
 		return tea.NewView(m.viewGameOver())
 	}
 	return tea.NewView(m.viewBoard())
 }
 
-// viewSelection renders the board size selection menu.
+// viewSelection renders the game settings menu.
 func (m *GoModel) viewSelection() string {
-	entries := make([]string, len(BoardSizes))
-	for i, d := range BoardSizes {
-		entry := fmt.Sprintf("%d × %d", d, d)
-		if m.cursor.Y == i {
-			entries[i] = SelectedListEntry.Render("> " + entry)
-		} else {
-			entries[i] = ListEntry.Render(entry)
-		}
+	mode := "Two players"
+	if m.vsComputer {
+		mode = "Computer"
+	}
+
+	handicap := "None"
+	if m.handicap > 0 {
+		handicap = fmt.Sprintf("%d stones", m.handicap)
+	}
+
+	entries := []string{
+		m.viewSetupEntry(setupSize, "Board size", fmt.Sprintf("%d × %d", m.boardSize, m.boardSize)),
+		m.viewSetupEntry(setupRules, "Scoring", Rulesets[m.ruleset].Name),
+		m.viewSetupEntry(setupKomi, "Komi", formatKomi(m.komi)),
+		m.viewSetupEntry(setupHandicap, "Handicap", handicap),
+		m.viewSetupEntry(setupMode, "Opponent", mode),
+	}
+	if m.vsComputer {
+		strength := Strengths[m.strength]
+		entries = append(entries,
+			m.viewSetupEntry(setupSide, "Play as", colorName(m.playerColor)),
+			m.viewSetupEntry(setupStrength, "Strength", fmt.Sprintf(
+				"%s (%d playouts)", strength.Name, strength.Playouts[m.boardSize],
+			)),
+		)
 	}
+	entries = append(entries, m.viewSetupEntry(setupStart, "Start", ""))
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		lipgloss.JoinHorizontal(
@@ -45,6 +65,23 @@ func (m *GoModel) viewSelection() string {
 	)
 }
 
+// viewSetupEntry renders a single row of the settings menu.
+func (m *GoModel) viewSetupEntry(row int, name, value string) string {
+	detail := "\n"
+	if value != "" {
+		detail = ListDetail.Render("‹ "+value+" ›") + "\n"
+	}
+
+	var entry string
+	if m.setupRow == row {
+		entry = SelectedListEntry.Render("> "+name) + "\n  " + detail
+	} else {
+		entry = ListEntry.Render(name + "\n" + detail)
+	}
+
+	return zone.Mark(fmt.Sprintf("setup_%d", row), entry)
+}
+
 // viewBoard assembles the full board UI.
 func (m *GoModel) viewBoard() string {
 	grid := m.viewGrid()
@@ -68,6 +105,56 @@ func (m *GoModel) viewBoard() string {
 	return lipgloss.JoinVertical(lipgloss.Center, ui, message)
 }
 
+// viewReview renders the position being reviewed with the review controls.
+func (m *GoModel) viewReview() string {
+	r := m.review
+	last := len(r.positions) - 1
+
+	// Draw a copy of the position so the display settings follow the model
+	p := *r.positions[r.index]
+	p.cursor = Position{X: -1, Y: -1}
+	p.showLabels = m.showLabels
+	p.showMoveNumbers = m.showMoveNumbers
+
+	borderStyle := Border
+	if p.showLabels {
+		borderStyle = BorderLabels
+	}
+	board := borderStyle.Render(
+		lipgloss.JoinVertical(lipgloss.Right, p.viewStatus(), p.viewGrid()),
+	)
+
+	title := fmt.Sprintf("Go — Review, %s to Play", colorName(p.turn))
+	if r.index == last && r.result != "" {
+		title = "Go — Review, Result " + r.result
+	}
+
+	status := fmt.Sprintf("Move %d of %d · ←/→ step · enter to play from here", r.index, last)
+	if r.live {
+		status += " · v to return"
+	}
+
+	ui := lipgloss.JoinVertical(lipgloss.Left, TitleStyle.Render(title), board, m.viewScrubber())
+	return lipgloss.JoinVertical(lipgloss.Center, ui, MessageStyle.Render("> "+status))
+}
+
+// viewScrubber renders a clickable timeline of the reviewed moves with a knob at the shown position.
+func (m *GoModel) viewScrubber() string {
+	r := m.review
+	knob := r.scrubberColumn()
+
+	cells := make([]string, 0, r.scrubberWidth())
+	for col := range r.scrubberWidth() {
+		cell := ListDetail.Render("━")
+		if col == knob {
+			cell = SelectedListEntry.Render("●")
+		}
+		cells = append(cells, zone.Mark(fmt.Sprintf("scrub_%d", col), cell))
+	}
+
+	return lipgloss.NewStyle().MarginTop(1).Render(lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+}
+
 // viewTitle builds the title bar showing whose turn it is and the board size.
 func (m *GoModel) viewTitle() string {
 	var title string
@@ -89,18 +176,29 @@ func (m *GoModel) viewGrid() string {
 	// capacity = top row + board + optional labels
 	rows := make([]string, 0, 2*(m.boardSize)+1)
 
+	// Number the stones along the moves played so far, the latest move at each point winning
+	var numbers map[Position]int
+	if m.showMoveNumbers {
+		numbers = make(map[Position]int)
+		for i, n := range m.line() {
+			if n.move != nil {
+				numbers[*n.move] = i + 1
+			}
+		}
+	}
+
 	// Top edge row
-	rows = append(rows, m.viewGridRow(gridTopLeft, gridTopIntersect, gridTopRight, 0))
+	rows = append(rows, m.viewGridRow(gridTopLeft, gridTopIntersect, gridTopRight, 0, numbers))
 
 	// Middle rows with connectors between them
 	for y := 1; y < m.boardSize-1; y++ {
 		rows = append(rows, m.viewGridConnectors())
-		rows = append(rows, m.viewGridRow(gridMidLeft, gridMidIntersect, gridMidRight, y))
+		rows = append(rows, m.viewGridRow(gridMidLeft, gridMidIntersect, gridMidRight, y, numbers))
 	}
 
 	// Bottom edge row
 	rows = append(rows, m.viewGridConnectors())
-	rows = append(rows, m.viewGridRow(gridBotLeft, gridBotIntersect, gridBotRight, m.boardSize-1))
+	rows = append(rows, m.viewGridRow(gridBotLeft, gridBotIntersect, gridBotRight, m.boardSize-1, numbers))
 
 	// Column labels below the board
 	if m.showLabels {
@@ -123,17 +221,22 @@ func (m *GoModel) viewColumnLabels() string {
 
 // viewMessage returns the rendered status message or an empty styled string.
 func (m *GoModel) viewMessage() string {
-	if m.message != "" {
-		if ansi.PrintableRuneWidth(m.message)%2 == 0 {
-			m.message += " "
+	message := m.message
+	if message == "" && m.thinking {
+		message = colorName(m.turn) + " is thinking..."
+	}
+
+	if message != "" {
+		if ansi.PrintableRuneWidth(message)%2 == 0 {
+			message += " "
 		}
-		return MessageStyle.Render("> " + m.message)
+		return MessageStyle.Render("> " + message)
 	}
 	return MessageStyle.Render("")
 }
 
-// viewGridRow renders a single row of intersection cells in the grid.
-func (m *GoModel) viewGridRow(leftCorner, intersection, rightCorner string, y int) string {
+// viewGridRow renders a single row of intersection cells in the grid, labelling stones with their move numbers.
+func (m *GoModel) viewGridRow(leftCorner, intersection, rightCorner string, y int, numbers map[Position]int) string {
 	connector := BoardLine.Render(gridHorizBar)
 
 	// capacity = optional label + (boardSize cells) + (boardSize-1 connectors).
@@ -145,22 +248,22 @@ func (m *GoModel) viewGridRow(leftCorner, intersection, rightCorner string, y in
 		row = append(row, LabelStyle.Render(label))
 	}
 
-	row = append(row, m.buildInteractiveCell(leftCorner, 0, y))
+	row = append(row, m.buildInteractiveCell(leftCorner, 0, y, numbers))
 
 	for i := 1; i < m.boardSize-1; i++ {
 		row = append(row, connector)
-		row = append(row, m.buildInteractiveCell(intersection, i, y))
+		row = append(row, m.buildInteractiveCell(intersection, i, y, numbers))
 	}
 
 	row = append(row, connector)
-	row = append(row, m.buildInteractiveCell(rightCorner, m.boardSize-1, y))
+	row = append(row, m.buildInteractiveCell(rightCorner, m.boardSize-1, y, numbers))
 
 	return lipgloss.JoinHorizontal(lipgloss.Top, row...)
 }
 
 // buildInteractiveCell creates a single intersection and wraps it in a clickable zone label.
-func (m *GoModel) buildInteractiveCell(intersection string, x, y int) string {
-	cell := m.viewIntersection(Position{X: x, Y: y}, intersection)
+func (m *GoModel) buildInteractiveCell(intersection string, x, y int, numbers map[Position]int) string {
+	cell := m.viewIntersection(Position{X: x, Y: y}, intersection, numbers)
 	return zone.Mark(fmt.Sprintf("%d_%d", x, y), cell)
 }
 
@@ -179,7 +282,7 @@ func (m *GoModel) viewGridConnectors() string {
 }
 
 // viewIntersection renders a single intersection or stone on the board.
-func (m *GoModel) viewIntersection(pos Position, defaultIntersection string) string {
+func (m *GoModel) viewIntersection(pos Position, defaultIntersection string, numbers map[Position]int) string {
 	cell := m.board.Cells[pos.Y][pos.X]
 
 	// Render marked dead stones
@@ -192,6 +295,36 @@ func (m *GoModel) viewIntersection(pos Position, defaultIntersection string) str
 		return CursorStyle.Render("▐█▌")
 	}
 
+	// Shade the points the ownership map gives to either player
+	if m.showOwnership && !m.markingDeadStones && m.ownership != nil {
+		owner := m.ownership[pos.Y*m.boardSize+pos.X]
+		switch {
+		case cell == Empty && owner <= -ownershipThreshold:
+			return viewTerritory(defaultIntersection, BlackStoneStyle)
+		case cell == Empty && owner >= ownershipThreshold:
+			return viewTerritory(defaultIntersection, WhiteStoneStyle)
+		case cell == Black && owner >= ownershipThreshold:
+			return BlackStoneStyle.Render("▐▒▌")
+		case cell == White && owner <= -ownershipThreshold:
+			return WhiteStoneStyle.Render("▐▒▌")
+		}
+	}
+
+	// Show the move number on stones when the overlay is on
+	if number, ok := numbers[pos]; ok && cell != Empty {
+		label := fmt.Sprintf("%-2d ", number)
+		if number < 10 {
+			label = fmt.Sprintf(" %d ", number)
+		} else if number >= 100 {
+			label = fmt.Sprintf("%d", number)
+		}
+
+		if cell == Black {
+			return BlackNumberStyle.Render(label)
+		}
+		return WhiteNumberStyle.Render(label)
+	}
+
 	// Render the base cell character
 	switch cell {
 	case Black:
@@ -203,6 +336,12 @@ func (m *GoModel) viewIntersection(pos Position, defaultIntersection string) str
 	}
 }
 
+// viewTerritory marks an empty intersection as territory with a small square in the owner's color.
+func viewTerritory(intersection string, style lipgloss.Style) string {
+	runes := []rune(intersection)
+	return BoardLine.Render(string(runes[0])) + style.Render("▪") + BoardLine.Render(string(runes[2]))
+}
+
 // viewStatus renders the capture counts line.
 func (m *GoModel) viewStatus() string {
 	return lipgloss.JoinHorizontal(
@@ -269,13 +408,12 @@ func (m *GoModel) viewGameOver() string {
 
 	winner = lipgloss.NewStyle().Foreground(color).Render(winner)
 
-	// Last move notation
-	details := ""
+	// Rules the game was counted under and the last move
+	details := fmt.Sprintf("%s rules, komi %s", Rulesets[m.ruleset].SGF, formatKomi(m.komi))
 	if m.lastMove != nil {
-		details = lipgloss.NewStyle().
-			Foreground(colors.Light2).
-			Render("Last move: " + formatPosition(*m.lastMove, m.boardSize))
+		details += " · Last move: " + formatPosition(*m.lastMove, m.boardSize)
 	}
+	details = lipgloss.NewStyle().Foreground(colors.Light2).Render(details)
 
 	return components.GameOver(color, m.viewBoard(), winner, details)
 }
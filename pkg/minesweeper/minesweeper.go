@@ -5,6 +5,8 @@ import (
 	"math/rand/v2"
 	"time"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
 )
@@ -64,6 +66,15 @@ type MinesweeperModel struct {
 	timerSeq     int
 }
 
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Minesweeper",
+		Category: registry.Classics,
+		Order:    4,
+		New:      func() tea.Model { return InitMinesweeperModel() },
+	})
+}
+
 // InitMinesweeperModel creates and initializes a new minesweeper model.
 func InitMinesweeperModel() *MinesweeperModel {
 	return &MinesweeperModel{
@@ -0,0 +1,140 @@
+package puzzles
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBaseURL is the root of the NYT games API.
+const DefaultBaseURL = "https://www.nytimes.com/svc/"
+
+// Source fetches raw puzzle data in the NYT response formats.
+type Source interface {
+	// Fetch returns the JSON for a path below the API root, such as "wordle/v2/2024-01-01.json".
+	Fetch(path string) ([]byte, error)
+}
+
+// HTTPSource fetches puzzles from the NYT API or a server that mirrors it.
+type HTTPSource struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// DirSource reads puzzles from JSON files laid out like the API paths below a directory,
+// so data/puzzles/wordle/v2/2024-01-01.json answers the Wordle request for that date.
+type DirSource struct {
+	Dir string
+}
+
+// StatusError is returned when the API answers with anything other than 200 OK.
+type StatusError struct {
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("non-OK HTTP status: %d", e.Code)
+}
+
+var (
+	mu      sync.RWMutex
+	current Source = NewHTTPSource(DefaultBaseURL)
+)
+
+// NewHTTPSource returns a source for an API root, using a 5 second timeout.
+func NewHTTPSource(baseURL string) *HTTPSource {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return &HTTPSource{
+		BaseURL: baseURL,
+		Client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Fetch requests a puzzle and returns the response body.
+func (s *HTTPSource) Fetch(path string) ([]byte, error) {
+	req, err := http.NewRequest("GET", s.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The crossword API only answers requests carrying this header
+	req.Header.Set("X-Games-Auth-Bypass", "true")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check HTTP status
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{Code: resp.StatusCode}
+	}
+
+	// Read the response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	return body, nil
+}
+
+// Fetch reads a puzzle file.
+func (s DirSource) Fetch(path string) ([]byte, error) {
+	body, err := os.ReadFile(filepath.Join(s.Dir, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("puzzle not available offline: %w", err)
+	}
+	return body, nil
+}
+
+// Configure picks the source used by the games: a local directory when dir is set,
+// otherwise the API at baseURL, or the NYT API when both are empty.
+func Configure(baseURL, dir string) {
+	switch {
+	case dir != "":
+		SetSource(DirSource{Dir: dir})
+	case baseURL != "":
+		SetSource(NewHTTPSource(baseURL))
+	}
+}
+
+// SetSource replaces the source used by the games.
+func SetSource(source Source) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = source
+}
+
+// Fetch returns the JSON for a puzzle path from the configured source.
+func Fetch(path string) ([]byte, error) {
+	mu.RLock()
+	source := current
+	mu.RUnlock()
+
+	return source.Fetch(path)
+}
+
+// Retryable reports whether a failed fetch may succeed if tried again. Missing puzzles and
+// rejected requests are permanent, while network errors, rate limits and server errors are not.
+func Retryable(err error) bool {
+	if errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.Code == http.StatusTooManyRequests || status.Code >= 500
+	}
+	return true
+}
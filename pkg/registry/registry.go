@@ -0,0 +1,202 @@
+package registry
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// Default menu categories, listed in the order they appear on the home screen.
+const (
+	Classics = "Classics"
+	NYT      = "New York Times"
+	Strategy = "Strategy Games"
+)
+
+// ReservedFlags are the command-line flags of the arcade itself, which launchers cannot use.
+var ReservedFlags = []string{"game", "no-mouse", "puzzle-url", "puzzle-dir", "h", "help"}
+
+// categoryOrder fixes the position of the built-in categories in the menu.
+// Categories not listed here are shown afterwards in the order they were first registered.
+var categoryOrder = []string{Classics, NYT, Strategy}
+
+// Saver defines a game model that can persist state.
+type Saver interface {
+	SaveToFile() error
+}
+
+// Helper defines a game model that can render a help page.
+type Helper interface {
+	Help() string
+}
+
+// Game describes a game that can be launched from the arcade menu.
+type Game struct {
+	// Name is the title shown in the menu and matched by the -game flag.
+	Name string
+
+	// Category is the menu header the game is listed under.
+	Category string
+
+	// Order positions the game within its category, lower values first.
+	Order int
+
+	// New constructs a fresh game model. A nil constructor marks the game as not implemented yet.
+	New func() tea.Model
+
+	// Launchers open the game directly from command-line flags, in order of precedence.
+	Launchers []Launcher
+}
+
+// Launcher opens a game from the value of a command-line flag, such as a saved game to replay.
+type Launcher struct {
+	// Flag is the flag name without the leading dash.
+	Flag string
+
+	// Usage is the flag description shown by -help.
+	Usage string
+
+	// Open builds the game model for the flag value.
+	Open func(value string) (tea.Model, error)
+}
+
+// Implemented reports whether the game can be launched.
+func (g Game) Implemented() bool {
+	return g.New != nil
+}
+
+// Category holds the games listed under a single menu header.
+type Category struct {
+	Header string
+	Games  []Game
+}
+
+var (
+	mu    sync.RWMutex
+	games []Game
+	seen  []string
+)
+
+// Register adds a game to the registry, replacing any game with the same name.
+// It is intended to be called from a game package's init function, and panics if a
+// launcher flag is reserved or already used by another game.
+func Register(g Game) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for i, launcher := range g.Launchers {
+		if err := checkFlag(g.Name, launcher.Flag); err != nil {
+			panic(err)
+		}
+		if slices.ContainsFunc(g.Launchers[:i], func(l Launcher) bool { return l.Flag == launcher.Flag }) {
+			panic(fmt.Sprintf("registry: %s registers launcher flag -%s twice", g.Name, launcher.Flag))
+		}
+	}
+
+	if !slices.Contains(seen, g.Category) {
+		seen = append(seen, g.Category)
+	}
+
+	for i := range games {
+		if games[i].Name == g.Name {
+			games[i] = g
+			return
+		}
+	}
+
+	games = append(games, g)
+}
+
+// checkFlag reports an error if a launcher flag of the named game cannot be registered.
+func checkFlag(name, flag string) error {
+	if flag == "" {
+		return fmt.Errorf("registry: %s has a launcher without a flag name", name)
+	}
+	if slices.Contains(ReservedFlags, flag) {
+		return fmt.Errorf("registry: %s launcher flag -%s is reserved by the arcade", name, flag)
+	}
+
+	for _, other := range games {
+		if other.Name == name {
+			continue
+		}
+		for _, launcher := range other.Launchers {
+			if launcher.Flag == flag {
+				return fmt.Errorf("registry: %s launcher flag -%s is already used by %s", name, flag, other.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Lookup finds a registered game by name, ignoring case and spaces.
+func Lookup(name string) (Game, bool) {
+	key := normalize(name)
+	for _, g := range All() {
+		if normalize(g.Name) == key {
+			return g, true
+		}
+	}
+
+	return Game{}, false
+}
+
+// Categories returns every registered game grouped by category in menu order.
+func Categories() []Category {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var categories []Category
+	for _, header := range orderedCategories() {
+		var list []Game
+		for _, g := range games {
+			if g.Category == header {
+				list = append(list, g)
+			}
+		}
+
+		if len(list) == 0 {
+			continue
+		}
+
+		// Sort by order, falling back to the name so the menu is stable across builds
+		slices.SortStableFunc(list, func(a, b Game) int {
+			if a.Order != b.Order {
+				return a.Order - b.Order
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		categories = append(categories, Category{Header: header, Games: list})
+	}
+
+	return categories
+}
+
+// All returns every registered game as a flat list in menu order.
+func All() []Game {
+	var all []Game
+	for _, category := range Categories() {
+		all = append(all, category.Games...)
+	}
+	return all
+}
+
+// orderedCategories returns the built-in categories followed by any custom ones.
+func orderedCategories() []string {
+	order := slices.Clone(categoryOrder)
+	for _, category := range seen {
+		if !slices.Contains(order, category) {
+			order = append(order, category)
+		}
+	}
+	return order
+}
+
+// normalize lowercases a name and strips spaces for lenient matching.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
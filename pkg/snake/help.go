@@ -0,0 +1,61 @@
+package snake
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	Header = lipgloss.NewStyle().Foreground(colors.Purple).Render(
+		`███████╗███╗   ██╗ █████╗ ██╗  ██╗███████╗
+██╔════╝████╗  ██║██╔══██╗██║ ██╔╝██╔════╝
+███████╗██╔██╗ ██║███████║█████╔╝ █████╗
+╚════██║██║╚██╗██║██╔══██║██╔═██╗ ██╔══╝
+███████║██║ ╚████║██║  ██║██║  ██╗███████╗
+╚══════╝╚═╝  ╚═══╝╚═╝  ╚═╝╚═╝  ╚═╝╚══════╝`,
+	)
+
+	Intro = `Guide the snake to the food and grow as long as you can
+without running into yourself.
+
+• Each piece of food grows the snake by one and scores more
+  points at higher speeds.
+• In walls mode hitting the edge of the board ends the game.
+• In wrap mode the snake passes through the edges and comes
+  out on the opposite side.
+• The best score is saved separately for every mode and speed.`
+)
+
+// Help returns the Snake help screen UI.
+func (m *SnakeModel) Help() string {
+	howToPlay := components.Section("How To Play", Intro)
+
+	menu := lipgloss.JoinVertical(
+		lipgloss.Left,
+		howToPlay,
+	)
+
+	// Define movement keybindings
+	movementKeybinds := []components.Keybind{
+		{Key: "↑ / w / k", Action: "up"},
+		{Key: "↓ / s / j", Action: "down"},
+		{Key: "← / a / h", Action: "left"},
+		{Key: "→ / d / l", Action: "right"},
+	}
+
+	gameKeybinds := []components.Keybind{
+		{Key: "p / esc", Action: "pause"},
+		{Key: "t", Action: "toggle wrap"},
+		{Key: "1-5", Action: "speed"},
+	}
+
+	keybinds := lipgloss.JoinVertical(
+		lipgloss.Center,
+		components.ViewWideKeybinds("Movement", movementKeybinds),
+		components.GameKeybinds(gameKeybinds),
+	)
+
+	return components.CreateHelpMenu(Header, menu, keybinds)
+}
@@ -0,0 +1,83 @@
+package snake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	_ "modernc.org/sqlite"
+)
+
+const filename = "data/snake/scores.db"
+
+// LoadHighScore returns the best score recorded for a mode, or zero if none exists.
+func LoadHighScore(mode string) (int, error) {
+	db, err := getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	var score int
+	row := db.QueryRow(`SELECT score FROM highscores WHERE mode = ?`, mode)
+	if err := row.Scan(&score); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return score, nil
+}
+
+// SaveHighScore stores the best score for a mode.
+func SaveHighScore(mode string, score int) error {
+	db, err := getDB()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`
+		INSERT OR REPLACE INTO highscores (mode, score)
+		VALUES (?, ?)
+	`, mode, score)
+
+	return err
+}
+
+var (
+	dbOnce sync.Once
+	dbConn *sql.DB
+	dbErr  error
+)
+
+// getDB returns the shared *sql.DB connection pool, initializing it once.
+func getDB() (*sql.DB, error) {
+	dbOnce.Do(func() {
+		if err := os.MkdirAll("data/snake", 0755); err != nil {
+			dbErr = fmt.Errorf("error creating data dir: %v", err)
+			return
+		}
+
+		db, err := sql.Open("sqlite", filename)
+		if err != nil {
+			dbErr = fmt.Errorf("error opening database: %v", err)
+			return
+		}
+
+		if _, err := db.Exec(`
+			CREATE TABLE IF NOT EXISTS highscores (
+				mode TEXT PRIMARY KEY,
+				score INTEGER
+			)
+		`); err != nil {
+			db.Close()
+			dbErr = fmt.Errorf("error creating table: %v", err)
+			return
+		}
+
+		dbConn = db
+	})
+	return dbConn, dbErr
+}
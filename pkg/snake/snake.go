@@ -0,0 +1,402 @@
+package snake
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"ascii-arcade/pkg/registry"
+
+	tea "charm.land/bubbletea/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+const (
+	boardWidth  = 24
+	boardHeight = 16
+	startLength = 3
+	maxQueued   = 2
+)
+
+// Speed defines how often the snake advances for a given level.
+type Speed struct {
+	Name     string
+	Interval time.Duration
+}
+
+// Speeds contains the selectable speed levels, slowest first.
+var Speeds = []Speed{
+	{"Slow", 180 * time.Millisecond},
+	{"Easy", 140 * time.Millisecond},
+	{"Normal", 110 * time.Millisecond},
+	{"Fast", 85 * time.Millisecond},
+	{"Insane", 60 * time.Millisecond},
+}
+
+// defaultSpeed is the level used when the game is first opened.
+const defaultSpeed = 2
+
+// Position represents a coordinate on the board.
+type Position struct {
+	X, Y int
+}
+
+// Directions the snake can travel in.
+var (
+	up    = Position{X: 0, Y: -1}
+	down  = Position{X: 0, Y: 1}
+	left  = Position{X: -1, Y: 0}
+	right = Position{X: 1, Y: 0}
+)
+
+// tickMsg is the internal message used to advance the snake.
+type tickMsg struct {
+	seq int
+}
+
+// SnakeModel represents the state of a Snake game.
+type SnakeModel struct {
+	rng *rand.Rand
+
+	// Settings
+	speed int
+	wrap  bool
+
+	// Board state
+	snake     []Position
+	occupied  map[Position]bool
+	food      Position
+	direction Position
+	queue     []Position
+
+	// Game state
+	score      int
+	highScore  int
+	newBest    bool
+	paused     bool
+	gameOver   bool
+	won        bool
+	hasStarted bool
+	tickSeq    int
+	message    string
+}
+
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Snake",
+		Category: registry.Classics,
+		Order:    2,
+		New:      func() tea.Model { return InitSnakeModel() },
+	})
+}
+
+// InitSnakeModel creates a new Snake game with the default settings.
+func InitSnakeModel() *SnakeModel {
+	return newGame(defaultSpeed, false)
+}
+
+// newGame creates a fresh board for the given speed level and wrap mode.
+func newGame(speed int, wrap bool) *SnakeModel {
+	m := &SnakeModel{
+		rng:       rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		speed:     speed,
+		wrap:      wrap,
+		occupied:  make(map[Position]bool, boardWidth*boardHeight),
+		direction: right,
+		tickSeq:   1,
+		message:   "Press an arrow key to start.",
+	}
+
+	// Lay the snake out horizontally in the middle of the board, head first
+	mid := Position{X: boardWidth / 3, Y: boardHeight / 2}
+	for i := range startLength {
+		p := Position{X: mid.X - i, Y: mid.Y}
+		m.snake = append(m.snake, p)
+		m.occupied[p] = true
+	}
+
+	m.placeFood()
+
+	highScore, err := LoadHighScore(m.modeKey())
+	if err != nil {
+		m.message = fmt.Sprintf("Failed to load high score: %v", err)
+	}
+	m.highScore = highScore
+
+	return m
+}
+
+// Init implements the Bubble Tea interface for initialization.
+func (m *SnakeModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles ticks, keypress, and mouse events.
+func (m *SnakeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tickMsg:
+		return m.handleTick(msg)
+	case tea.KeyPressMsg:
+		return m.handleKey(msg)
+	case tea.MouseClickMsg:
+		return m.handleMouseClick(msg)
+	}
+
+	return m, nil
+}
+
+// handleTick advances the snake by one cell.
+func (m *SnakeModel) handleTick(msg tickMsg) (tea.Model, tea.Cmd) {
+	// Do not process stale ticks
+	if msg.seq != m.tickSeq {
+		return m, nil
+	}
+
+	// Do not process ticks when game shouldn't be running
+	if !m.hasStarted || m.paused || m.gameOver {
+		return m, nil
+	}
+
+	// Apply the next buffered turn, if any
+	if len(m.queue) > 0 {
+		m.direction = m.queue[0]
+		m.queue = m.queue[1:]
+	}
+
+	head, ok := m.nextHead()
+	if !ok {
+		m.endGame()
+		return m, nil
+	}
+
+	// The tail moves out of the way unless the snake is about to grow
+	eating := head == m.food
+	tail := m.snake[len(m.snake)-1]
+	if !eating {
+		delete(m.occupied, tail)
+	}
+
+	if m.occupied[head] {
+		m.occupied[tail] = true
+		m.endGame()
+		return m, nil
+	}
+
+	// Advance the snake
+	m.snake = append([]Position{head}, m.snake...)
+	m.occupied[head] = true
+	if !eating {
+		m.snake = m.snake[:len(m.snake)-1]
+	}
+
+	if eating {
+		m.score += m.speed + 1
+
+		// Filling the board is a win
+		if len(m.snake) == boardWidth*boardHeight {
+			m.won = true
+			m.endGame()
+			return m, nil
+		}
+
+		m.placeFood()
+	}
+
+	return m, m.scheduleTick()
+}
+
+// handleKey handles keyboard input.
+func (m *SnakeModel) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	// Always allow resetting and changing settings
+	switch key {
+	case "ctrl+r":
+		return newGame(m.speed, m.wrap), nil
+	case "1", "2", "3", "4", "5":
+		return newGame(int(key[0]-'1'), m.wrap), nil
+	case "t":
+		return newGame(m.speed, !m.wrap), nil
+	}
+
+	// Game over keybinds
+	if m.gameOver {
+		if key == "enter" {
+			return m, func() tea.Msg { return "home" }
+		}
+		return m, nil
+	}
+
+	// Pause toggle must work while paused and playing
+	if key == "p" || key == "esc" {
+		return m.togglePause()
+	}
+
+	// Ignore gameplay keys while paused
+	if m.paused {
+		return m, nil
+	}
+
+	switch key {
+	case "up", "w", "k":
+		return m, m.turn(up)
+	case "down", "s", "j":
+		return m, m.turn(down)
+	case "left", "a", "h":
+		return m, m.turn(left)
+	case "right", "d", "l":
+		return m, m.turn(right)
+	}
+
+	return m, nil
+}
+
+// handleMouseClick handles mouse interactions.
+func (m *SnakeModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// Only respond to left clicks
+	if msg.Mouse().Button != tea.MouseLeft {
+		return m, nil
+	}
+
+	// Handle game over UI
+	if m.gameOver {
+		switch {
+		case zone.Get("reset").InBounds(msg):
+			return newGame(m.speed, m.wrap), nil
+		case zone.Get("exit").InBounds(msg):
+			return m, func() tea.Msg { return "home" }
+		}
+	}
+
+	return m, nil
+}
+
+// turn buffers a direction change, ignoring reversals and repeats.
+// Buffering lets quick successive turns register on consecutive ticks.
+func (m *SnakeModel) turn(dir Position) tea.Cmd {
+	last := m.direction
+	if len(m.queue) > 0 {
+		last = m.queue[len(m.queue)-1]
+	}
+
+	// The snake cannot reverse into itself
+	isReverse := dir.X == -last.X && dir.Y == -last.Y
+	if dir == last || isReverse || len(m.queue) >= maxQueued {
+		return m.startIfNeeded()
+	}
+
+	m.queue = append(m.queue, dir)
+	return m.startIfNeeded()
+}
+
+// nextHead returns the cell the head moves into, and false if it leaves the board.
+func (m *SnakeModel) nextHead() (Position, bool) {
+	head := m.snake[0]
+	next := Position{X: head.X + m.direction.X, Y: head.Y + m.direction.Y}
+
+	inBounds := next.X >= 0 && next.X < boardWidth && next.Y >= 0 && next.Y < boardHeight
+	if inBounds {
+		return next, true
+	}
+
+	if !m.wrap {
+		return next, false
+	}
+
+	// Wrap around to the opposite edge
+	next.X = (next.X + boardWidth) % boardWidth
+	next.Y = (next.Y + boardHeight) % boardHeight
+	return next, true
+}
+
+// placeFood drops food on a random empty cell.
+func (m *SnakeModel) placeFood() {
+	free := boardWidth*boardHeight - len(m.occupied)
+	if free == 0 {
+		return
+	}
+
+	// Pick the n-th free cell so placement never loops on a crowded board
+	n := m.rng.IntN(free)
+	for y := range boardHeight {
+		for x := range boardWidth {
+			p := Position{X: x, Y: y}
+			if m.occupied[p] {
+				continue
+			}
+			if n == 0 {
+				m.food = p
+				return
+			}
+			n--
+		}
+	}
+}
+
+// endGame stops the game and records a new high score.
+func (m *SnakeModel) endGame() {
+	m.gameOver = true
+	m.tickSeq++
+
+	if m.score <= m.highScore {
+		return
+	}
+
+	m.highScore = m.score
+	m.newBest = true
+	if err := SaveHighScore(m.modeKey(), m.score); err != nil {
+		m.message = fmt.Sprintf("Failed to save high score: %v", err)
+	}
+}
+
+// scheduleTick returns a tea.Cmd that fires a tickMsg after the current speed interval has elapsed.
+// The tick is tagged with the current tickSeq so any older ticks can be invalidated by bumping the counter.
+func (m *SnakeModel) scheduleTick() tea.Cmd {
+	seq := m.tickSeq
+	return tea.Tick(Speeds[m.speed].Interval, func(time.Time) tea.Msg {
+		return tickMsg{seq: seq}
+	})
+}
+
+// togglePause flips the paused state.
+func (m *SnakeModel) togglePause() (tea.Model, tea.Cmd) {
+	if !m.hasStarted {
+		return m, nil
+	}
+
+	m.paused = !m.paused
+
+	// Bump tickSeq so any in-flight tick is dropped
+	m.tickSeq++
+
+	if m.paused {
+		m.message = "Paused."
+		return m, nil
+	}
+
+	// Schedule a fresh tick on resume
+	m.message = ""
+	return m, m.scheduleTick()
+}
+
+// startIfNeeded schedules the first tick when the player makes their first move.
+func (m *SnakeModel) startIfNeeded() tea.Cmd {
+	if m.hasStarted {
+		return nil
+	}
+
+	m.hasStarted = true
+	m.message = ""
+	m.tickSeq++
+
+	return m.scheduleTick()
+}
+
+// modeKey identifies the settings a high score was set with.
+func (m *SnakeModel) modeKey() string {
+	mode := "walls"
+	if m.wrap {
+		mode = "wrap"
+	}
+	return fmt.Sprintf("%s-%s", mode, Speeds[m.speed].Name)
+}
@@ -0,0 +1,61 @@
+package snake
+
+import (
+	"ascii-arcade/internal/colors"
+
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	cellFilled = "██"
+	cellFood   = "▐▌"
+	cellEmpty  = "  "
+)
+
+var (
+	renderedHead  = lipgloss.NewStyle().Foreground(colors.Cyan).Render(cellFilled)
+	renderedBody  = lipgloss.NewStyle().Foreground(colors.Green).Render(cellFilled)
+	renderedFood  = lipgloss.NewStyle().Foreground(colors.Red).Render(cellFood)
+	renderedEmpty = lipgloss.NewStyle().Foreground(colors.Dark1).Render(cellEmpty)
+	renderedCrash = lipgloss.NewStyle().Foreground(colors.Orange).Render(cellFilled)
+)
+
+var (
+	// Walls are solid when wrapping is off and dimmed when the snake can pass through them
+	Playfield = lipgloss.NewStyle().
+			Border(lipgloss.ThickBorder()).
+			BorderForeground(colors.Light2)
+
+	WrapPlayfield = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(colors.Medium2)
+
+	SidePanel = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(colors.Medium1).
+			Padding(0, 1).
+			MarginRight(1).
+			MarginLeft(1)
+
+	PanelLabel = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			Bold(true).
+			Underline(true)
+
+	infoLabel  = PanelLabel.Render("Info")
+	modeLabel  = PanelLabel.Render("Mode")
+	speedLabel = PanelLabel.Render("Speed")
+
+	InfoLabel = lipgloss.NewStyle().Foreground(colors.Light2).Bold(true)
+	InfoValue = lipgloss.NewStyle().Foreground(colors.Purple).Bold(true)
+
+	ListEntry         = lipgloss.NewStyle().Foreground(colors.Medium2)
+	SelectedListEntry = lipgloss.NewStyle().Foreground(colors.Pink)
+
+	MessageStyle = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			MarginTop(1).
+			Bold(true)
+
+	InfoBox = SidePanel.Width(14)
+)
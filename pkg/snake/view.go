@@ -0,0 +1,131 @@
+package snake
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// View renders the entire Snake game view.
+func (m *SnakeModel) View() tea.View {
+	if m.gameOver {
+		return tea.NewView(m.viewGameOver())
+	}
+	return tea.NewView(m.viewGame())
+}
+
+// viewGame renders the board with the info and settings panels beside it.
+func (m *SnakeModel) viewGame() string {
+	board := lipgloss.JoinVertical(
+		lipgloss.Center,
+		m.viewBoard(),
+		MessageStyle.Render(m.message),
+	)
+
+	// While paused, hide the side panels and show only the board
+	if m.paused {
+		return board
+	}
+
+	right := lipgloss.JoinVertical(lipgloss.Left,
+		m.viewInfo(),
+		m.viewSettings(),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, board, right)
+}
+
+// viewBoard renders the playfield with the snake and food.
+func (m *SnakeModel) viewBoard() string {
+	var board strings.Builder
+	board.Grow(boardWidth*boardHeight*20 + boardHeight)
+
+	head := m.snake[0]
+	for y := range boardHeight {
+		for x := range boardWidth {
+			p := Position{X: x, Y: y}
+			switch {
+			case p == head && m.gameOver && !m.won:
+				board.WriteString(renderedCrash)
+			case p == head:
+				board.WriteString(renderedHead)
+			case m.occupied[p]:
+				board.WriteString(renderedBody)
+			case p == m.food:
+				board.WriteString(renderedFood)
+			default:
+				board.WriteString(renderedEmpty)
+			}
+		}
+		if y < boardHeight-1 {
+			board.WriteByte('\n')
+		}
+	}
+
+	if m.wrap {
+		return WrapPlayfield.Render(board.String())
+	}
+	return Playfield.Render(board.String())
+}
+
+// viewInfo renders Score, Best, and Length stacked vertically.
+func (m *SnakeModel) viewInfo() string {
+	rows := []string{
+		infoLabel,
+		infoRow("Score", strconv.Itoa(m.score)),
+		infoRow("Best", strconv.Itoa(m.highScore)),
+		infoRow("Length", strconv.Itoa(len(m.snake))),
+	}
+	return InfoBox.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// viewSettings renders the current wrap mode and the speed levels.
+func (m *SnakeModel) viewSettings() string {
+	mode := "Walls"
+	if m.wrap {
+		mode = "Wrap"
+	}
+
+	rows := []string{modeLabel, InfoValue.Render(mode), "", speedLabel}
+	for i, speed := range Speeds {
+		entry := fmt.Sprintf("%d %s", i+1, speed.Name)
+		if i == m.speed {
+			rows = append(rows, SelectedListEntry.Render(entry))
+		} else {
+			rows = append(rows, ListEntry.Render(entry))
+		}
+	}
+
+	return InfoBox.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// infoRow formats a "label: value" line for the info panel.
+func infoRow(label, value string) string {
+	return InfoLabel.Render(label+":") + " " + InfoValue.Render(value)
+}
+
+// viewGameOver renders the end of game UI.
+func (m *SnakeModel) viewGameOver() string {
+	result := "You crashed!"
+	if m.won {
+		result = "You filled the board!"
+	}
+
+	rows := []string{
+		result,
+		"",
+		infoRow("Score", strconv.Itoa(m.score)),
+		infoRow("Best", strconv.Itoa(m.highScore)),
+	}
+	if m.newBest {
+		rows = append(rows, "", InfoValue.Render("New high score!"))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Center, rows...)
+	return components.GameOver(colors.Purple, m.viewGame(), content)
+}
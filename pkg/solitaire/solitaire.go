@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
@@ -26,6 +28,15 @@ type SolitaireModel struct {
 	moves       []Move
 }
 
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Solitaire",
+		Category: registry.Classics,
+		Order:    3,
+		New:      func() tea.Model { return InitSolitaireModel() },
+	})
+}
+
 // InitSolitaireModel creates and initializes a new solitaire model.
 func InitSolitaireModel() *SolitaireModel {
 	// Create a new shuffled deck for the stock
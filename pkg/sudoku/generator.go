@@ -0,0 +1,102 @@
+package sudoku
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+)
+
+// maxAttempts bounds how many puzzles are generated while searching for the requested difficulty.
+const maxAttempts = 30
+
+// minClues keeps easier puzzles from being carved down to a sparse grid.
+var minClues = []int{36, 28, 25, 17}
+
+// Generate creates a uniquely solvable puzzle of the given difficulty.
+// The same seed always produces the same puzzle, so every player gets the same daily grid.
+func Generate(seed uint64, difficulty int) (puzzle, solution Grid) {
+	rng := rand.New(rand.NewPCG(seed, uint64(difficulty)))
+
+	bestGrade := -1
+	for range maxAttempts {
+		full := randomSolution(rng)
+		candidate := carve(rng, full, difficulty)
+
+		// Keep the closest match in case no attempt hits the target exactly
+		grade := Grade(candidate)
+		if grade > bestGrade {
+			puzzle, solution, bestGrade = candidate, full, grade
+		}
+		if grade == difficulty {
+			break
+		}
+	}
+
+	return puzzle, solution
+}
+
+// DailySeed derives a generator seed from a date string.
+func DailySeed(date string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(date))
+	return h.Sum64()
+}
+
+// randomSolution fills an empty grid with a random valid solution.
+func randomSolution(rng *rand.Rand) Grid {
+	var g Grid
+
+	var fill func(cell int) bool
+	fill = func(cell int) bool {
+		if cell == 81 {
+			return true
+		}
+
+		mask := g.candidates(cell)
+		for _, d := range rng.Perm(9) {
+			digit := uint8(d + 1)
+			if mask&(1<<digit) == 0 {
+				continue
+			}
+			g[cell] = digit
+			if fill(cell + 1) {
+				return true
+			}
+		}
+		g[cell] = 0
+
+		return false
+	}
+
+	fill(0)
+	return g
+}
+
+// carve removes clues from a full grid in random order. A clue is only removed if the puzzle
+// stays uniquely solvable and does not become harder than the target difficulty.
+func carve(rng *rand.Rand, full Grid, difficulty int) Grid {
+	puzzle := full
+	clues := 81
+	for _, cell := range rng.Perm(81) {
+		if clues <= minClues[difficulty] {
+			break
+		}
+
+		removed := puzzle[cell]
+		puzzle[cell] = 0
+
+		if n, _ := countSolutions(puzzle, 2); n != 1 {
+			puzzle[cell] = removed
+			continue
+		}
+
+		// Expert puzzles only need to be unique
+		if difficulty < Expert && Grade(puzzle) > difficulty {
+			puzzle[cell] = removed
+			continue
+		}
+
+		clues--
+	}
+
+	return puzzle
+}
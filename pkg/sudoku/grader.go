@@ -0,0 +1,326 @@
+package sudoku
+
+import (
+	"math/bits"
+)
+
+// Difficulty levels, graded by the hardest technique a human needs to solve the puzzle.
+const (
+	Easy = iota
+	Medium
+	Hard
+	Expert
+)
+
+// Difficulties names each difficulty level.
+var Difficulties = []string{"Easy", "Medium", "Hard", "Expert"}
+
+// Techniques lists the solving techniques allowed at each difficulty.
+var Techniques = [][]string{
+	{"Naked singles", "Hidden singles"},
+	{"Locked candidates", "Naked pairs"},
+	{"Hidden pairs", "Naked triples", "X-Wing"},
+	{"Trial and error"},
+}
+
+// technique is a single deduction step that reports whether it made progress.
+type technique struct {
+	level int
+	apply func(s *logicSolver) bool
+}
+
+// techniques is ordered from simplest to hardest so the grader always uses the easiest step available.
+var techniques = []technique{
+	{Easy, (*logicSolver).nakedSingles},
+	{Easy, (*logicSolver).hiddenSingles},
+	{Medium, (*logicSolver).lockedCandidates},
+	{Medium, func(s *logicSolver) bool { return s.nakedSubsets(2) }},
+	{Hard, (*logicSolver).hiddenPairs},
+	{Hard, func(s *logicSolver) bool { return s.nakedSubsets(3) }},
+	{Hard, (*logicSolver).xWing},
+}
+
+// logicSolver solves a puzzle with human techniques while tracking pencil mark candidates.
+type logicSolver struct {
+	grid  Grid
+	cands [81]uint16
+}
+
+// Grade returns the difficulty of a puzzle.
+// Puzzles that cannot be finished with the listed techniques are graded Expert.
+func Grade(g Grid) int {
+	s := newLogicSolver(g)
+	level := Easy
+
+	for !s.solved() {
+		progress := false
+		for _, t := range techniques {
+			if t.apply(s) {
+				level = max(level, t.level)
+				progress = true
+				break
+			}
+		}
+
+		if !progress {
+			return Expert
+		}
+	}
+
+	return level
+}
+
+// newLogicSolver fills in the candidates for every empty cell of a grid.
+func newLogicSolver(g Grid) *logicSolver {
+	s := &logicSolver{grid: g}
+	for cell, v := range g {
+		if v == 0 {
+			s.cands[cell] = g.candidates(cell)
+		}
+	}
+	return s
+}
+
+// solved reports whether every cell has been filled.
+func (s *logicSolver) solved() bool {
+	for _, v := range s.grid {
+		if v == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// place fills a cell and removes the digit from its peers' candidates.
+func (s *logicSolver) place(cell int, d uint8) {
+	s.grid[cell] = d
+	s.cands[cell] = 0
+	for _, p := range peers[cell] {
+		s.cands[p] &^= 1 << d
+	}
+}
+
+// eliminate removes candidates from a cell and reports whether anything changed.
+func (s *logicSolver) eliminate(cell int, mask uint16) bool {
+	if s.cands[cell]&mask == 0 {
+		return false
+	}
+	s.cands[cell] &^= mask
+	return true
+}
+
+// nakedSingles fills every cell that has exactly one candidate.
+func (s *logicSolver) nakedSingles() bool {
+	progress := false
+	for cell, mask := range s.cands {
+		if s.grid[cell] == 0 && bits.OnesCount16(mask) == 1 {
+			s.place(cell, uint8(bits.TrailingZeros16(mask)))
+			progress = true
+		}
+	}
+	return progress
+}
+
+// hiddenSingles fills cells that are the only place for a digit within a unit.
+func (s *logicSolver) hiddenSingles() bool {
+	for _, unit := range units {
+		for d := uint8(1); d <= 9; d++ {
+			only, count := -1, 0
+			for _, cell := range unit {
+				if s.cands[cell]&(1<<d) != 0 {
+					only = cell
+					count++
+				}
+			}
+			if count == 1 {
+				s.place(only, d)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lockedCandidates handles pointing and claiming: when a digit within one unit is confined to
+// its intersection with another unit, it can be removed from the rest of the other unit.
+func (s *logicSolver) lockedCandidates() bool {
+	for _, pair := range intersections {
+		a, b := units[pair[0]], pair[1]
+		for d := uint8(1); d <= 9; d++ {
+			bit := uint16(1) << d
+			found, confined := false, true
+			for _, cell := range a {
+				if s.cands[cell]&bit == 0 {
+					continue
+				}
+				found = true
+				if !inUnit[b][cell] {
+					confined = false
+					break
+				}
+			}
+			if !found || !confined {
+				continue
+			}
+
+			progress := false
+			for _, cell := range units[b] {
+				if !inUnit[pair[0]][cell] && s.eliminate(cell, bit) {
+					progress = true
+				}
+			}
+			if progress {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nakedSubsets finds n cells in a unit whose combined candidates are exactly n digits,
+// and removes those digits from the rest of the unit.
+func (s *logicSolver) nakedSubsets(n int) bool {
+	for _, unit := range units {
+		var empty []int
+		for _, cell := range unit {
+			if s.grid[cell] == 0 {
+				empty = append(empty, cell)
+			}
+		}
+
+		for _, subset := range combinations(len(empty), n) {
+			var union uint16
+			for _, i := range subset {
+				union |= s.cands[empty[i]]
+			}
+			if bits.OnesCount16(union) != n {
+				continue
+			}
+
+			progress := false
+			for i, cell := range empty {
+				if !containsIndex(subset, i) && s.eliminate(cell, union) {
+					progress = true
+				}
+			}
+			if progress {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hiddenPairs finds two digits confined to the same two cells of a unit,
+// and removes every other candidate from those cells.
+func (s *logicSolver) hiddenPairs() bool {
+	for _, unit := range units {
+		var places [10][]int
+		for d := 1; d <= 9; d++ {
+			for _, cell := range unit {
+				if s.cands[cell]&(1<<d) != 0 {
+					places[d] = append(places[d], cell)
+				}
+			}
+		}
+
+		for d1 := 1; d1 <= 9; d1++ {
+			for d2 := d1 + 1; d2 <= 9; d2++ {
+				if len(places[d1]) != 2 || len(places[d2]) != 2 {
+					continue
+				}
+				if places[d1][0] != places[d2][0] || places[d1][1] != places[d2][1] {
+					continue
+				}
+
+				keep := uint16(1)<<d1 | uint16(1)<<d2
+				progress := false
+				for _, cell := range places[d1] {
+					if s.eliminate(cell, ^keep) {
+						progress = true
+					}
+				}
+				if progress {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// xWing finds a digit confined to the same two columns in two rows (or the same two rows in
+// two columns), and removes it from the rest of those columns (or rows).
+func (s *logicSolver) xWing() bool {
+	// Rows are units 0-8 and columns 9-17, so each pass treats one as base and the other as cover
+	for _, base := range [2]int{0, 9} {
+		cover := 9 - base
+		for d := uint8(1); d <= 9; d++ {
+			bit := uint16(1) << d
+
+			// Record the positions of the digit within each base unit
+			var positions [9][]int
+			for i := range 9 {
+				for j, cell := range units[base+i] {
+					if s.cands[cell]&bit != 0 {
+						positions[i] = append(positions[i], j)
+					}
+				}
+			}
+
+			for r1 := range 9 {
+				for r2 := r1 + 1; r2 < 9; r2++ {
+					p1, p2 := positions[r1], positions[r2]
+					if len(p1) != 2 || len(p2) != 2 || p1[0] != p2[0] || p1[1] != p2[1] {
+						continue
+					}
+
+					progress := false
+					for _, c := range p1 {
+						for i, cell := range units[cover+c] {
+							if i != r1 && i != r2 && s.eliminate(cell, bit) {
+								progress = true
+							}
+						}
+					}
+					if progress {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// combinations returns every k-element subset of the indices 0..n-1.
+func combinations(n, k int) [][]int {
+	var result [][]int
+	subset := make([]int, 0, k)
+
+	var build func(start int)
+	build = func(start int) {
+		if len(subset) == k {
+			result = append(result, append([]int(nil), subset...))
+			return
+		}
+		for i := start; i < n; i++ {
+			subset = append(subset, i)
+			build(i + 1)
+			subset = subset[:len(subset)-1]
+		}
+	}
+
+	build(0)
+	return result
+}
+
+// containsIndex reports whether a subset includes index i.
+func containsIndex(subset []int, i int) bool {
+	for _, v := range subset {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
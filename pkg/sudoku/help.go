@@ -0,0 +1,71 @@
+package sudoku
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	Header = lipgloss.NewStyle().Foreground(colors.Purple).Render(
+		`███████╗██╗   ██╗██████╗  ██████╗ ██╗  ██╗██╗   ██╗
+██╔════╝██║   ██║██╔══██╗██╔═══██╗██║ ██╔╝██║   ██║
+███████╗██║   ██║██║  ██║██║   ██║█████╔╝ ██║   ██║
+╚════██║██║   ██║██║  ██║██║   ██║██╔═██╗ ██║   ██║
+███████║╚██████╔╝██████╔╝╚██████╔╝██║  ██╗╚██████╔╝
+╚══════╝ ╚═════╝ ╚═════╝  ╚═════╝ ╚═╝  ╚═╝ ╚═════╝`,
+	)
+
+	Intro = `Fill the grid so that every row, column, and 3×3 box contains
+the digits 1 through 9 exactly once.
+
+• A new puzzle is generated every day for each difficulty and
+  always has exactly one solution.
+• Difficulty is graded by the hardest technique needed to solve
+  the puzzle without guessing.
+• Use notes mode to pencil in candidate digits.
+• Digits that repeat in a row, column, or box are shown in red.`
+)
+
+// Help returns the Sudoku help screen UI.
+func (m *SudokuModel) Help() string {
+	howToPlay := components.Section("How To Play", Intro)
+
+	menu := lipgloss.JoinVertical(
+		lipgloss.Left,
+		howToPlay,
+	)
+
+	movementKeybinds := []components.Keybind{
+		{Key: "↑ / w / k", Action: "up"},
+		{Key: "↓ / s / j", Action: "down"},
+		{Key: "← / a / h", Action: "left"},
+		{Key: "→ / d / l", Action: "right"},
+	}
+
+	keyboardKeybinds := []components.Keybind{
+		{Key: "1-9", Action: "enter digit"},
+		{Key: "0 / bksp", Action: "erase"},
+		{Key: "n", Action: "toggle notes"},
+		{Key: "ctrl+n", Action: "new puzzle"},
+	}
+
+	mouseKeybinds := []components.Keybind{
+		{Key: "click cell", Action: "select cell"},
+		{Key: "click digit", Action: "enter digit"},
+		{Key: "click notes", Action: "toggle notes"},
+	}
+
+	keyboard := components.ViewWideKeybinds("Keyboard", keyboardKeybinds)
+	mouse := components.ViewWideKeybinds("Mouse", mouseKeybinds)
+	movement := components.ViewKeybinds("Movement", movementKeybinds)
+
+	keybinds := lipgloss.JoinVertical(
+		lipgloss.Center,
+		components.JoinKeybinds(keyboard, mouse),
+		components.JoinKeybinds(movement, components.GlobalKeybinds()),
+	)
+
+	return components.CreateHelpMenu(Header, menu, keybinds)
+}
@@ -0,0 +1,177 @@
+package sudoku
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	_ "modernc.org/sqlite"
+)
+
+const filename = "data/sudoku/games.db"
+
+// LoadGame returns the Sudoku game state for a given date and difficulty.
+func LoadGame(date string, difficulty int) (SudokuModel, error) {
+	// Try loading the saved game from the database
+	model, err := LoadFromFile(date, difficulty)
+	if err == nil {
+		return model, nil
+	}
+
+	// Generate the daily puzzle if there is no save for it
+	puzzle, solution := Generate(DailySeed(date), difficulty)
+	model = SudokuModel{
+		date:        date,
+		difficulty:  difficulty,
+		puzzle:      puzzle,
+		solution:    solution,
+		grid:        puzzle,
+		cursor:      Position{X: 4, Y: 4},
+		hasSelected: true,
+	}
+
+	// A missing row is expected the first time a puzzle is opened
+	if errors.Is(err, sql.ErrNoRows) {
+		return model, nil
+	}
+	return model, err
+}
+
+// SaveToFile writes the current game state to a SQLite database.
+func (m *SudokuModel) SaveToFile() error {
+	// Nothing to save until a puzzle has been chosen
+	if !m.hasSelected {
+		return nil
+	}
+
+	db, err := getDB()
+	if err != nil {
+		return err
+	}
+
+	notesJSON, _ := json.Marshal(m.notes)
+
+	// Insert the data into the database
+	_, err = db.Exec(`
+		INSERT OR REPLACE INTO sudoku (date, difficulty, puzzle, solution, grid, notes, cursor_x, cursor_y, note_mode)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, m.date, m.difficulty, m.puzzle.String(), m.solution.String(), m.grid.String(), notesJSON, m.cursor.X, m.cursor.Y, m.noteMode)
+
+	return err
+}
+
+// LoadFromFile loads a Sudoku game state from the SQLite database.
+func LoadFromFile(date string, difficulty int) (SudokuModel, error) {
+	model := SudokuModel{
+		date:        date,
+		difficulty:  difficulty,
+		hasSelected: true,
+	}
+
+	db, err := getDB()
+	if err != nil {
+		return model, err
+	}
+
+	// Get the saved game data from the database
+	row := db.QueryRow(`
+		SELECT puzzle, solution, grid, notes, cursor_x, cursor_y, note_mode
+		FROM sudoku WHERE date = ? AND difficulty = ?
+	`, date, difficulty)
+
+	var puzzle, solution, grid string
+	var notesJSON []byte
+	if err := row.Scan(&puzzle, &solution, &grid, &notesJSON, &model.cursor.X, &model.cursor.Y, &model.noteMode); err != nil {
+		return model, err
+	}
+
+	// Decode the grids
+	for _, g := range []struct {
+		dst *Grid
+		src string
+	}{{&model.puzzle, puzzle}, {&model.solution, solution}, {&model.grid, grid}} {
+		if err := g.dst.parse(g.src); err != nil {
+			return model, err
+		}
+	}
+
+	json.Unmarshal(notesJSON, &model.notes)
+
+	model.solved = model.grid == model.solution
+	if model.solved {
+		model.message = "🎉 Puzzle solved! 🎉"
+	}
+
+	return model, nil
+}
+
+// String encodes a grid as 81 digits in row-major order.
+func (g Grid) String() string {
+	b := make([]byte, 81)
+	for i, v := range g {
+		b[i] = '0' + v
+	}
+	return string(b)
+}
+
+// parse decodes a grid from the format produced by String.
+func (g *Grid) parse(s string) error {
+	if len(s) != 81 {
+		return fmt.Errorf("invalid grid length: %d", len(s))
+	}
+
+	for i := range 81 {
+		if s[i] < '0' || s[i] > '9' {
+			return fmt.Errorf("invalid grid character: %q", s[i])
+		}
+		g[i] = s[i] - '0'
+	}
+	return nil
+}
+
+var (
+	dbOnce sync.Once
+	dbConn *sql.DB
+	dbErr  error
+)
+
+// getDB returns the shared *sql.DB connection pool, initializing it once.
+func getDB() (*sql.DB, error) {
+	dbOnce.Do(func() {
+		if err := os.MkdirAll("data/sudoku", 0755); err != nil {
+			dbErr = fmt.Errorf("error creating data dir: %v", err)
+			return
+		}
+
+		db, err := sql.Open("sqlite", filename)
+		if err != nil {
+			dbErr = fmt.Errorf("error opening database: %v", err)
+			return
+		}
+
+		if _, err := db.Exec(`
+			CREATE TABLE IF NOT EXISTS sudoku (
+				date TEXT,
+				difficulty INTEGER,
+				puzzle TEXT,
+				solution TEXT,
+				grid TEXT,
+				notes TEXT,
+				cursor_x INTEGER,
+				cursor_y INTEGER,
+				note_mode BOOLEAN,
+				PRIMARY KEY (date, difficulty)
+			)
+		`); err != nil {
+			db.Close()
+			dbErr = fmt.Errorf("error creating table: %v", err)
+			return
+		}
+
+		dbConn = db
+	})
+	return dbConn, dbErr
+}
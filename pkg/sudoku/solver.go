@@ -0,0 +1,159 @@
+package sudoku
+
+import (
+	"math/bits"
+)
+
+// Grid holds the 81 cells of a puzzle in row-major order, with 0 marking an empty cell.
+type Grid [81]uint8
+
+// allCandidates has bits 1 through 9 set.
+const allCandidates uint16 = 0x3FE
+
+var (
+	// units lists the cell indices of every row, column, and box.
+	units [27][9]int
+
+	// peers lists the 20 cells that share a unit with each cell.
+	peers [81][]int
+
+	// inUnit reports whether a cell belongs to a unit.
+	inUnit [27][81]bool
+
+	// intersections pairs each box with every row and column it overlaps, in both directions.
+	intersections [][2]int
+)
+
+func init() {
+	for i := range 9 {
+		for j := range 9 {
+			units[i][j] = i*9 + j                          // Row i
+			units[9+i][j] = j*9 + i                        // Column i
+			units[18+i][j] = (i/3*3+j/3)*9 + (i%3*3 + j%3) // Box i
+		}
+	}
+
+	for u, unit := range units {
+		for _, cell := range unit {
+			inUnit[u][cell] = true
+		}
+	}
+
+	for box := 18; box < 27; box++ {
+		for line := range 18 {
+			if shared := sharedCells(box, line); shared > 1 {
+				intersections = append(intersections, [2]int{box, line}, [2]int{line, box})
+			}
+		}
+	}
+
+	for cell := range 81 {
+		seen := map[int]bool{cell: true}
+		for u, unit := range units {
+			if !inUnit[u][cell] {
+				continue
+			}
+			for _, p := range unit {
+				if !seen[p] {
+					seen[p] = true
+					peers[cell] = append(peers[cell], p)
+				}
+			}
+		}
+	}
+}
+
+// sharedCells counts the cells two units have in common.
+func sharedCells(a, b int) int {
+	n := 0
+	for _, cell := range units[a] {
+		if inUnit[b][cell] {
+			n++
+		}
+	}
+	return n
+}
+
+// candidates returns the digits that can legally be placed in a cell as a bitmask.
+func (g *Grid) candidates(cell int) uint16 {
+	mask := allCandidates
+	for _, p := range peers[cell] {
+		mask &^= 1 << g[p]
+	}
+	return mask
+}
+
+// isValid reports whether no filled cell repeats a digit within its row, column, or box.
+func (g *Grid) isValid() bool {
+	for cell, v := range g {
+		if v == 0 {
+			continue
+		}
+		for _, p := range peers[cell] {
+			if g[p] == v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// countSolutions counts the solutions of a grid, stopping once limit is reached.
+// It returns the first solution found so callers can solve and check uniqueness in one pass.
+func countSolutions(g Grid, limit int) (int, Grid) {
+	var first Grid
+	count := 0
+
+	var search func(g *Grid) bool
+	search = func(g *Grid) bool {
+		// Choose the empty cell with the fewest candidates
+		best, bestMask, bestCount := -1, uint16(0), 10
+		for cell, v := range g {
+			if v != 0 {
+				continue
+			}
+			mask := g.candidates(cell)
+			n := bits.OnesCount16(mask)
+			if n < bestCount {
+				best, bestMask, bestCount = cell, mask, n
+				if n <= 1 {
+					break
+				}
+			}
+		}
+
+		// No empty cells left means the grid is solved
+		if best == -1 {
+			if count == 0 {
+				first = *g
+			}
+			count++
+			return count >= limit
+		}
+
+		for d := uint8(1); d <= 9; d++ {
+			if bestMask&(1<<d) == 0 {
+				continue
+			}
+			g[best] = d
+			if search(g) {
+				return true
+			}
+		}
+		g[best] = 0
+
+		return false
+	}
+
+	if g.isValid() {
+		search(&g)
+	}
+
+	return count, first
+}
+
+// Solve returns the solution of a grid and whether it exists and is unique.
+func Solve(g Grid) (Grid, bool) {
+	n, solution := countSolutions(g, 2)
+	return solution, n == 1
+}
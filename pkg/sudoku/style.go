@@ -0,0 +1,76 @@
+package sudoku
+
+import (
+	"ascii-arcade/internal/colors"
+
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	CellWidth = 5
+
+	gridTopLeft      = "┏"
+	gridTopIntersect = "┳"
+	gridTopRight     = "┓"
+	gridMidLeft      = "┣"
+	gridMidIntersect = "╋"
+	gridMidRight     = "┫"
+	gridBotLeft      = "┗"
+	gridBotIntersect = "┻"
+	gridBotRight     = "┛"
+	gridHorizBar     = "━"
+	gridVertBar      = "┃"
+)
+
+var (
+	Even      = colors.Light1
+	Odd       = colors.Light2
+	Cursor    = colors.Yellow
+	Match     = colors.Cyan
+	Conflict  = colors.Red
+	Entry     = colors.Purple
+	DarkText  = colors.Dark1
+	LightText = colors.Light2
+	GreyText  = colors.Medium2
+
+	GridLine = lipgloss.NewStyle().Foreground(colors.Medium1)
+
+	// Cell backgrounds
+	EvenCell   = lipgloss.NewStyle().Width(CellWidth).Foreground(DarkText).Background(Even)
+	OddCell    = lipgloss.NewStyle().Width(CellWidth).Foreground(DarkText).Background(Odd)
+	CursorCell = lipgloss.NewStyle().Width(CellWidth).Foreground(DarkText).Background(Cursor)
+	MatchCell  = lipgloss.NewStyle().Width(CellWidth).Foreground(DarkText).Background(Match)
+
+	LabelStyle = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginBottom(1).
+			Bold(true)
+
+	ButtonStyle = lipgloss.NewStyle().
+			Foreground(colors.Dark1).
+			Background(colors.Purple).
+			Padding(0, 1).
+			MarginTop(1).
+			MarginRight(1).
+			Bold(true)
+
+	DoneButtonStyle = ButtonStyle.
+			Background(colors.Medium2)
+
+	ListEntry = lipgloss.NewStyle().
+			Foreground(colors.Light2).
+			MarginLeft(2)
+
+	SelectedListEntry = lipgloss.NewStyle().
+				Foreground(colors.Pink)
+
+	ListDetail = lipgloss.NewStyle().
+			Foreground(colors.Medium2)
+
+	MessageStyle = lipgloss.NewStyle().
+			Foreground(LightText).
+			MarginTop(1).
+			Bold(true)
+)
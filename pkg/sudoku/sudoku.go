@@ -0,0 +1,292 @@
+package sudoku
+
+import (
+	"fmt"
+	"time"
+
+	"ascii-arcade/pkg/registry"
+
+	tea "charm.land/bubbletea/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+// Position represents a coordinate in the 9x9 grid.
+type Position struct {
+	X, Y int
+}
+
+// SudokuModel represents the state of a Sudoku game.
+type SudokuModel struct {
+	// Puzzle data
+	date       string
+	difficulty int
+	puzzle     Grid
+	solution   Grid
+
+	// Player state
+	grid     Grid
+	notes    [81]uint16
+	cursor   Position
+	noteMode bool
+
+	// Game state
+	hasSelected bool
+	solved      bool
+	message     string
+}
+
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Sudoku",
+		Category: registry.NYT,
+		Order:    5,
+		New:      func() tea.Model { return InitSudokuModel() },
+	})
+}
+
+// InitSudokuModel creates a Sudoku model on the difficulty selection screen.
+func InitSudokuModel() *SudokuModel {
+	return &SudokuModel{
+		date:    time.Now().Format("2006-01-02"),
+		message: "Select a difficulty.",
+	}
+}
+
+// Init implements the Bubble Tea interface for initialization.
+func (m *SudokuModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles keypress and mouse events.
+func (m *SudokuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		return m.handleKeyPress(msg)
+	case tea.MouseClickMsg:
+		return m.handleMouseClick(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input.
+func (m *SudokuModel) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	// Difficulty selection menu
+	if !m.hasSelected {
+		switch key {
+		case "up", "w":
+			m.cursor.Y = (m.cursor.Y - 1 + len(Difficulties)) % len(Difficulties)
+		case "down", "s":
+			m.cursor.Y = (m.cursor.Y + 1) % len(Difficulties)
+		case "enter":
+			return m.selectDifficulty(m.cursor.Y)
+		}
+		return m, nil
+	}
+
+	switch key {
+	case "ctrl+r":
+		m.handleReset()
+		return m, nil
+	case "ctrl+n":
+		// Save progress and return to the difficulty menu
+		m.SaveToFile()
+		next := InitSudokuModel()
+		next.cursor.Y = m.difficulty
+		return next, nil
+	}
+
+	// Disable editing once the puzzle is solved
+	if m.solved {
+		if key == "enter" {
+			return m, func() tea.Msg { return "home" }
+		}
+		return m, nil
+	}
+
+	switch key {
+	case "up", "w", "k":
+		m.cursor.Y = (m.cursor.Y + 8) % 9
+	case "down", "s", "j":
+		m.cursor.Y = (m.cursor.Y + 1) % 9
+	case "left", "a", "h":
+		m.cursor.X = (m.cursor.X + 8) % 9
+	case "right", "d", "l":
+		m.cursor.X = (m.cursor.X + 1) % 9
+	case "n":
+		m.noteMode = !m.noteMode
+	case "backspace", "delete", "0":
+		m.handleErase(m.cursor)
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		m.handleDigit(m.cursor, key[0]-'0')
+	}
+
+	return m, nil
+}
+
+// handleMouseClick handles mouse interactions.
+func (m *SudokuModel) handleMouseClick(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// Only respond to left clicks
+	if msg.Mouse().Button != tea.MouseLeft {
+		return m, nil
+	}
+
+	// Difficulty selection menu
+	if !m.hasSelected {
+		for i := range Difficulties {
+			if zone.Get(fmt.Sprintf("difficulty_%d", i)).InBounds(msg) {
+				return m.selectDifficulty(i)
+			}
+		}
+		return m, nil
+	}
+
+	// Handle game over buttons
+	if m.solved {
+		switch {
+		case zone.Get("reset").InBounds(msg):
+			m.handleReset()
+		case zone.Get("exit").InBounds(msg):
+			return m, func() tea.Msg { return "home" }
+		}
+		return m, nil
+	}
+
+	// Number pad and tool buttons
+	for d := uint8(1); d <= 9; d++ {
+		if zone.Get(fmt.Sprintf("digit_%d", d)).InBounds(msg) {
+			m.handleDigit(m.cursor, d)
+			return m, nil
+		}
+	}
+
+	switch {
+	case zone.Get("notes").InBounds(msg):
+		m.noteMode = !m.noteMode
+		return m, nil
+	case zone.Get("erase").InBounds(msg):
+		m.handleErase(m.cursor)
+		return m, nil
+	}
+
+	// Check if a cell was clicked
+	for y := range 9 {
+		for x := range 9 {
+			if zone.Get(fmt.Sprintf("%d_%d", x, y)).InBounds(msg) {
+				m.cursor = Position{X: x, Y: y}
+				return m, nil
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// selectDifficulty loads today's puzzle for a difficulty, generating it if needed.
+func (m *SudokuModel) selectDifficulty(difficulty int) (tea.Model, tea.Cmd) {
+	next, err := LoadGame(m.date, difficulty)
+	if err != nil {
+		next.message = fmt.Sprintf("Failed to load saved game: %v", err)
+	}
+
+	return &next, nil
+}
+
+// handleReset clears every entry and pencil mark, keeping only the givens.
+func (m *SudokuModel) handleReset() {
+	m.grid = m.puzzle
+	m.notes = [81]uint16{}
+	m.solved = false
+	m.message = ""
+	m.SaveToFile()
+}
+
+// handleDigit places a digit or toggles a pencil mark at p.
+func (m *SudokuModel) handleDigit(p Position, d uint8) {
+	cell := p.Y*9 + p.X
+
+	// Givens cannot be changed
+	if m.puzzle[cell] != 0 {
+		m.message = "That cell is part of the puzzle."
+		return
+	}
+	m.message = ""
+
+	if m.noteMode {
+		// Pencil marks only apply to empty cells
+		if m.grid[cell] == 0 {
+			m.notes[cell] ^= 1 << d
+		}
+		return
+	}
+
+	// Entering the same digit again clears the cell
+	if m.grid[cell] == d {
+		m.grid[cell] = 0
+		return
+	}
+
+	m.grid[cell] = d
+	m.clearPeerNotes(cell, d)
+	m.checkSolved()
+}
+
+// handleErase clears the digit or, if empty, the pencil marks at p.
+func (m *SudokuModel) handleErase(p Position) {
+	cell := p.Y*9 + p.X
+	if m.puzzle[cell] != 0 {
+		return
+	}
+
+	if m.grid[cell] != 0 {
+		m.grid[cell] = 0
+	} else {
+		m.notes[cell] = 0
+	}
+}
+
+// clearPeerNotes removes a placed digit from the pencil marks that can no longer hold it.
+func (m *SudokuModel) clearPeerNotes(cell int, d uint8) {
+	for _, p := range peers[cell] {
+		m.notes[p] &^= 1 << d
+	}
+}
+
+// checkSolved ends the game when the grid matches the solution.
+func (m *SudokuModel) checkSolved() {
+	if m.grid != m.solution {
+		return
+	}
+
+	m.solved = true
+	m.message = "🎉 Puzzle solved! 🎉"
+	m.SaveToFile()
+}
+
+// conflicts reports whether the digit in a cell repeats within its row, column, or box.
+func (m *SudokuModel) conflicts(cell int) bool {
+	v := m.grid[cell]
+	if v == 0 {
+		return false
+	}
+
+	for _, p := range peers[cell] {
+		if m.grid[p] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// remaining counts how many more times a digit must be placed.
+func (m *SudokuModel) remaining(d uint8) int {
+	count := 9
+	for _, v := range m.grid {
+		if v == d {
+			count--
+		}
+	}
+	return max(count, 0)
+}
@@ -0,0 +1,180 @@
+package sudoku
+
+import (
+	"ascii-arcade/internal/colors"
+	"ascii-arcade/internal/components"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	zone "github.com/lrstanley/bubblezone/v2"
+)
+
+// View renders the entire Sudoku UI.
+func (m *SudokuModel) View() tea.View {
+	if !m.hasSelected {
+		return tea.NewView(m.viewSelection())
+	}
+	if m.solved {
+		return tea.NewView(m.viewSolved())
+	}
+	return tea.NewView(m.viewGame())
+}
+
+// viewSelection renders the difficulty selection menu.
+func (m *SudokuModel) viewSelection() string {
+	entries := make([]string, len(Difficulties))
+	for i, name := range Difficulties {
+		detail := ListDetail.Render(strings.Join(Techniques[i], ", ") + "\n")
+		if m.cursor.Y == i {
+			entries[i] = SelectedListEntry.Render("> "+name) + "\n  " + detail
+		} else {
+			entries[i] = ListEntry.Render(name + "\n" + detail)
+		}
+		entries[i] = zone.Mark(fmt.Sprintf("difficulty_%d", i), entries[i])
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		LabelStyle.Render("Sudoku — "+m.date),
+		lipgloss.JoinVertical(lipgloss.Left, entries...),
+		MessageStyle.Render(m.message),
+	)
+}
+
+// viewGame renders the grid, number pad, and status message.
+func (m *SudokuModel) viewGame() string {
+	title := LabelStyle.Render(fmt.Sprintf("Sudoku — %s — %s", Difficulties[m.difficulty], m.date))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		title,
+		m.viewGrid(),
+		m.viewNumberPad(),
+		MessageStyle.Render(m.message),
+	)
+}
+
+// viewSolved renders the completed puzzle with the game over overlay.
+func (m *SudokuModel) viewSolved() string {
+	return components.GameOver(colors.Purple, m.viewGame(), "Puzzle solved!")
+}
+
+// viewGrid renders the 9x9 grid with thick lines between boxes.
+func (m *SudokuModel) viewGrid() string {
+	rows := []string{viewGridLine(gridTopLeft, gridTopIntersect, gridTopRight)}
+	for y := range 9 {
+		rows = append(rows, m.viewGridRow(y))
+		switch {
+		case y == 8:
+			rows = append(rows, viewGridLine(gridBotLeft, gridBotIntersect, gridBotRight))
+		case y%3 == 2:
+			rows = append(rows, viewGridLine(gridMidLeft, gridMidIntersect, gridMidRight))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// viewGridLine renders a horizontal box border using the given corner and junction pieces.
+func viewGridLine(left, intersect, right string) string {
+	bar := strings.Repeat(gridHorizBar, CellWidth*3)
+	return GridLine.Render(left + bar + intersect + bar + intersect + bar + right)
+}
+
+// viewGridRow renders one row of cells, each three lines tall.
+func (m *SudokuModel) viewGridRow(y int) string {
+	divider := GridLine.Render(strings.TrimSuffix(strings.Repeat(gridVertBar+"\n", 3), "\n"))
+
+	cells := []string{divider}
+	for x := range 9 {
+		cells = append(cells, zone.Mark(fmt.Sprintf("%d_%d", x, y), m.viewCell(x, y)))
+		if x%3 == 2 {
+			cells = append(cells, divider)
+		}
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
+
+// viewCell renders a single cell, showing its digit or pencil marks.
+func (m *SudokuModel) viewCell(x, y int) string {
+	cell := y*9 + x
+	cursor := m.cursor.Y*9 + m.cursor.X
+
+	// Pick the background from the cursor, matching digits, or box shading
+	var style lipgloss.Style
+	switch {
+	case cell == cursor:
+		style = CursorCell
+	case m.grid[cell] != 0 && m.grid[cell] == m.grid[cursor]:
+		style = MatchCell
+	case (x/3+y/3)%2 == 0:
+		style = EvenCell
+	default:
+		style = OddCell
+	}
+
+	v := m.grid[cell]
+	switch {
+	case v == 0:
+		return style.Foreground(GreyText).Render(viewNotes(m.notes[cell]))
+	case m.conflicts(cell):
+		style = style.Foreground(Conflict).Bold(true)
+	case m.puzzle[cell] != 0:
+		style = style.Bold(true)
+	default:
+		style = style.Foreground(Entry).Bold(true)
+	}
+
+	blank := strings.Repeat(" ", CellWidth)
+	digit := fmt.Sprintf("  %d  ", v)
+	return style.Render(blank + "\n" + digit + "\n" + blank)
+}
+
+// viewNotes lays out pencil marks as a 3x3 block of digits.
+func viewNotes(notes uint16) string {
+	lines := make([]string, 3)
+	for row := range 3 {
+		var b strings.Builder
+		b.WriteByte(' ')
+		for col := range 3 {
+			d := row*3 + col + 1
+			if notes&(1<<d) != 0 {
+				b.WriteByte(byte('0' + d))
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteByte(' ')
+		lines[row] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// viewNumberPad renders clickable digit buttons with the notes and erase tools.
+func (m *SudokuModel) viewNumberPad() string {
+	var buttons []string
+	for d := uint8(1); d <= 9; d++ {
+		style := ButtonStyle
+		if m.remaining(d) == 0 {
+			style = DoneButtonStyle
+		}
+		buttons = append(buttons, zone.Mark(fmt.Sprintf("digit_%d", d), style.Render(fmt.Sprint(d))))
+	}
+
+	notes := "Notes: Off"
+	if m.noteMode {
+		notes = "Notes: On"
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		lipgloss.JoinHorizontal(lipgloss.Top, buttons...),
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			zone.Mark("notes", ButtonStyle.Render(notes)),
+			zone.Mark("erase", ButtonStyle.Render("Erase")),
+		),
+	)
+}
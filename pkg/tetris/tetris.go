@@ -4,6 +4,8 @@ import (
 	"math/rand/v2"
 	"time"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
 
@@ -38,6 +40,15 @@ type TetrisModel struct {
 	pausedDuration time.Duration
 }
 
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Tetris",
+		Category: registry.Classics,
+		Order:    1,
+		New:      func() tea.Model { return InitTetrisModel() },
+	})
+}
+
 // InitTetrisModel creates a new Tetris game model with a freshly seeded random source.
 func InitTetrisModel() *TetrisModel {
 	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
@@ -0,0 +1,174 @@
+package wordle
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FirstDate is the date of the first Wordle puzzle.
+const FirstDate = "2021-06-19"
+
+// archiveRows is the number of dates shown at once in the archive picker.
+const archiveRows = 12
+
+// archive is the date picker listing every past puzzle, newest first.
+type archive struct {
+	dates    []string
+	statuses map[string]string
+	cursor   int
+	offset   int
+}
+
+// InitWordleModelForDate opens the puzzle for a past date, given as YYYY-MM-DD.
+func InitWordleModelForDate(date string) (*WordleModel, error) {
+	if err := validateDate(date); err != nil {
+		return nil, err
+	}
+
+	m, err := LoadGame(date)
+	if err != nil {
+		m.message = fmt.Sprintf("Failed to load wordle: %v", err)
+	}
+	return &m, nil
+}
+
+// validateDate checks that a date is in the archive, between the first puzzle and today.
+func validateDate(date string) error {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+
+	if day.Format("2006-01-02") < FirstDate || day.Format("2006-01-02") > time.Now().Format("2006-01-02") {
+		return fmt.Errorf("no Wordle for %s, puzzles run from %s to today", date, FirstDate)
+	}
+	return nil
+}
+
+// gameOutcome reads a saved game, returning the guesses a win took, or zero, and whether it is over.
+func gameOutcome(answer string, guesses [6]string, cursorY int) (solvedIn int, finished bool) {
+	for i, guess := range guesses {
+		if guess == answer && i < cursorY {
+			return i + 1, true
+		}
+	}
+	return 0, cursorY >= 6
+}
+
+// LoadStatuses describes the progress of every saved game, keyed by date.
+func LoadStatuses() (map[string]string, error) {
+	db, err := getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(`SELECT date, answer, guesses, cursor_y FROM wordle`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := make(map[string]string)
+	for rows.Next() {
+		var date, answer string
+		var guessesJSON []byte
+		var cursorY int
+		if err := rows.Scan(&date, &answer, &guessesJSON, &cursorY); err != nil {
+			return nil, err
+		}
+
+		var guesses [6]string
+		json.Unmarshal(guessesJSON, &guesses)
+
+		solvedIn, finished := gameOutcome(answer, guesses, cursorY)
+		switch {
+		case solvedIn > 0:
+			statuses[date] = fmt.Sprintf("solved in %d", solvedIn)
+		case finished:
+			statuses[date] = "failed"
+		case cursorY > 0:
+			statuses[date] = "in progress"
+		}
+	}
+
+	return statuses, rows.Err()
+}
+
+// handleOpenArchive saves the current game and opens the date picker on its date.
+func (m *WordleModel) handleOpenArchive() {
+	if err := m.SaveToFile(); err != nil {
+		m.message = fmt.Sprintf("Failed to save wordle: %v", err)
+		return
+	}
+
+	statuses, err := LoadStatuses()
+	if err != nil {
+		m.message = fmt.Sprintf("Failed to load archive: %v", err)
+		return
+	}
+
+	// List every date from today back to the first puzzle
+	a := &archive{statuses: statuses}
+	first, _ := time.Parse("2006-01-02", FirstDate)
+	for day := time.Now(); !day.Before(first); day = day.AddDate(0, 0, -1) {
+		date := day.Format("2006-01-02")
+		if date == m.date {
+			a.cursor = len(a.dates)
+		}
+		a.dates = append(a.dates, date)
+	}
+
+	a.scroll()
+	m.archive = a
+}
+
+// handleArchiveKey moves through the date picker and opens the chosen puzzle.
+func (m *WordleModel) handleArchiveKey(key string) {
+	a := m.archive
+
+	switch key {
+	case "up":
+		a.cursor--
+	case "down":
+		a.cursor++
+	case "pgup":
+		a.cursor -= archiveRows
+	case "pgdown":
+		a.cursor += archiveRows
+	case "home":
+		a.cursor = 0
+	case "end":
+		a.cursor = len(a.dates) - 1
+	case "esc", "ctrl+a":
+		m.archive = nil
+		return
+	case "enter":
+		loaded, err := LoadGame(a.dates[a.cursor])
+		if err != nil {
+			loaded.message = fmt.Sprintf("Failed to load wordle: %v", err)
+		}
+		*m = loaded
+		return
+	}
+
+	a.cursor = min(max(a.cursor, 0), len(a.dates)-1)
+	a.scroll()
+}
+
+// scroll keeps the cursor within the visible rows.
+func (a *archive) scroll() {
+	if a.cursor < a.offset {
+		a.offset = a.cursor
+	} else if a.cursor >= a.offset+archiveRows {
+		a.offset = a.cursor - archiveRows + 1
+	}
+}
+
+// status returns the progress of the puzzle for a date.
+func (a *archive) status(date string) string {
+	if status, ok := a.statuses[date]; ok {
+		return status
+	}
+	return "unplayed"
+}
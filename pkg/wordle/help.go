@@ -23,8 +23,14 @@ var (
 • The color of the tiles will change to show how
   close your guess was to the word.
 
-• The game fetches the latest Wordle puzzle from NYT.
-• Your progress is saved automatically.`
+• The game fetches the latest Wordle puzzle from NYT; past
+  puzzles can be picked from the archive.
+• Your progress is saved automatically.
+• Statistics and the guess distribution open after each game.
+• Hard mode, switched on before the first guess, makes every
+  later guess use the revealed hints.
+• Finished games can be shared as an emoji grid, copied to the
+  clipboard and saved to a file.`
 )
 
 // Help returns the Wordle help screen UI
@@ -43,6 +49,11 @@ func (m *WordleModel) Help() string {
 		{Key: "<char>", Action: "input"},
 		{Key: "bksp", Action: "erase"},
 		{Key: "enter", Action: "submit"},
+		{Key: "tab", Action: "statistics"},
+		{Key: "ctrl+t", Action: "hard mode"},
+		{Key: "ctrl+a", Action: "archive"},
+		{Key: "ctrl+s", Action: "share result"},
+		{Key: "ctrl+g", Action: "share with guesses"},
 	}
 
 	return components.CreateHelpMenu(Header, menu, components.GameKeybinds(keybinds))
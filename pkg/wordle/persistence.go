@@ -4,12 +4,11 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strings"
 	"sync"
-	"time"
+
+	"ascii-arcade/pkg/puzzles"
 
 	_ "modernc.org/sqlite"
 )
@@ -51,28 +50,12 @@ func LoadGame(date string) (WordleModel, error) {
 	return model, nil
 }
 
-// fetchWordleAnswer fetches the Wordle answer for today from the NYT API.
+// fetchWordleAnswer fetches the Wordle answer for a date from the puzzle source.
 func fetchWordleAnswer(date string) ([5]byte, error) {
 	var answer [5]byte
-	url := fmt.Sprintf("https://www.nytimes.com/svc/wordle/v2/%s.json", date)
-
-	// Make the GET request
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Get(url)
-	if err != nil {
-		return answer, fmt.Errorf("error fetching Wordle answer: %v", err)
-	}
-	defer resp.Body.Close()
-
-	// Check HTTP status
-	if resp.StatusCode != http.StatusOK {
-		return answer, fmt.Errorf("non-OK HTTP status: %d", resp.StatusCode)
-	}
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
+	body, err := puzzles.Fetch(fmt.Sprintf("wordle/v2/%s.json", date))
 	if err != nil {
-		return answer, fmt.Errorf("error reading response body: %v", err)
+		return answer, fmt.Errorf("error fetching Wordle answer: %w", err)
 	}
 
 	// Decode JSON
@@ -85,8 +68,13 @@ func fetchWordleAnswer(date string) ([5]byte, error) {
 	return answer, nil
 }
 
-// SaveToFile writes the current game state to a SQLite database.
+// SaveToFile writes the current game state to a SQLite database. Games that failed to
+// load have no answer and are not saved, so the puzzle is fetched again next time.
 func (m *WordleModel) SaveToFile() error {
+	if m.answer == [5]byte{} {
+		return nil
+	}
+
 	db, err := getDB()
 	if err != nil {
 		return err
@@ -110,9 +98,9 @@ func (m *WordleModel) SaveToFile() error {
 
 	// Insert the data into the database
 	_, err = db.Exec(`
-		INSERT OR REPLACE INTO wordle (date, answer, guesses, cursor_x, cursor_y, keyboard)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, m.date, string(m.answer[:]), guessesJSON, m.cursorX, m.cursorY, keyboardJSON)
+		INSERT OR REPLACE INTO wordle (date, answer, guesses, cursor_x, cursor_y, keyboard, hard_mode)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, m.date, string(m.answer[:]), guessesJSON, m.cursorX, m.cursorY, keyboardJSON, m.hardMode)
 
 	return err
 }
@@ -134,9 +122,9 @@ func LoadFromFile(date string) (WordleModel, error) {
 	}
 
 	// Get the saved game data from the database
-	row := db.QueryRow(`SELECT answer, guesses, cursor_x, cursor_y, keyboard FROM wordle WHERE date = ?`, date)
+	row := db.QueryRow(`SELECT answer, guesses, cursor_x, cursor_y, keyboard, hard_mode FROM wordle WHERE date = ?`, date)
 	var answer, guessesJSON, keyboardJSON []byte
-	if err := row.Scan(&answer, &guessesJSON, &model.cursorX, &model.cursorY, &keyboardJSON); err != nil {
+	if err := row.Scan(&answer, &guessesJSON, &model.cursorX, &model.cursorY, &keyboardJSON, &model.hardMode); err != nil {
 		return model, err
 	}
 
@@ -187,7 +175,8 @@ func getDB() (*sql.DB, error) {
 				guesses TEXT,
 				cursor_x INTEGER,
 				cursor_y INTEGER,
-				keyboard TEXT
+				keyboard TEXT,
+				hard_mode INTEGER NOT NULL DEFAULT 0
 			)
 		`); err != nil {
 			db.Close()
@@ -195,7 +184,39 @@ func getDB() (*sql.DB, error) {
 			return
 		}
 
+		// Databases created before hard mode existed need the new column
+		if err := addColumn(db, "hard_mode", "INTEGER NOT NULL DEFAULT 0"); err != nil {
+			db.Close()
+			dbErr = fmt.Errorf("error updating table: %v", err)
+			return
+		}
+
 		dbConn = db
 	})
 	return dbConn, dbErr
 }
+
+// addColumn adds a column to the wordle table unless it already exists.
+func addColumn(db *sql.DB, name, definition string) error {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info('wordle')`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return err
+		}
+		if column == name {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = db.Exec(fmt.Sprintf(`ALTER TABLE wordle ADD COLUMN %s %s`, name, definition))
+	return err
+}
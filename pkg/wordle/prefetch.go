@@ -0,0 +1,52 @@
+package wordle
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// storeMu serialises prefetch writes so concurrent downloads do not contend for the database.
+var storeMu sync.Mutex
+
+// Stored reports whether the answer for a date is already saved. Rows written for a game that
+// failed to load hold an answer of NUL bytes and do not count.
+func Stored(date string) (bool, error) {
+	db, err := getDB()
+	if err != nil {
+		return false, err
+	}
+
+	var answer string
+	err = db.QueryRow(`SELECT answer FROM wordle WHERE date = ?`, date).Scan(&answer)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return strings.Trim(answer, "\x00") != "", err
+}
+
+// Prefetch downloads the puzzle for a date and saves it as an unplayed game, leaving
+// games that are already saved untouched.
+func Prefetch(date string) error {
+	answer, err := fetchWordleAnswer(date)
+	if err != nil {
+		return err
+	}
+
+	storeMu.Lock()
+	defer storeMu.Unlock()
+
+	// The game may have been opened while the download was running
+	if stored, err := Stored(date); err != nil || stored {
+		return err
+	}
+
+	model := WordleModel{
+		date:     date,
+		answer:   answer,
+		keyboard: make(map[byte]int, 26),
+	}
+	model.handleReset()
+	return model.SaveToFile()
+}
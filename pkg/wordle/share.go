@@ -0,0 +1,102 @@
+package wordle
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// shareDir is where share cards are written.
+const shareDir = "data/wordle"
+
+// Emoji squares for each letter state in a share card
+var shareSquares = map[int]string{
+	keyAbsent:  "⬛",
+	keyPresent: "🟨",
+	keyCorrect: "🟩",
+}
+
+// puzzleNumber returns the NYT number of the puzzle for a date, counting from Wordle 0 on the first date.
+func puzzleNumber(date string) int {
+	first, _ := time.Parse("2006-01-02", FirstDate)
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0
+	}
+	return int(day.Sub(first).Hours() / 24)
+}
+
+// groupThousands formats a number with commas between groups of three digits, as in "Wordle 1,234".
+func groupThousands(n int) string {
+	digits := strconv.Itoa(n)
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return digits
+}
+
+// shareCard builds the "Wordle N X/6" emoji grid for a finished game. The spoiler-free card
+// is the usual grid of squares, while the full card also writes each guess beside its row.
+func (m *WordleModel) shareCard(spoilerFree bool) string {
+	// Losses are scored as X and hard mode wins are starred
+	score := "X"
+	rows := m.cursorY
+	if solved := m.solvedIn(); solved > 0 {
+		score = strconv.Itoa(solved)
+		rows = solved
+	}
+	score += "/6"
+	if m.hardMode {
+		score += "*"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Wordle %s %s\n\n", groupThousands(puzzleNumber(m.date)), score)
+
+	for _, guess := range m.guesses[:rows] {
+		for _, state := range scoreGuess(guess, m.answer) {
+			b.WriteString(shareSquares[state])
+		}
+		if !spoilerFree {
+			b.WriteString(" " + string(guess[:]))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// saveShareCard writes a share card to a file named after the puzzle date and returns its path.
+func (m *WordleModel) saveShareCard(card string) (string, error) {
+	if err := os.MkdirAll(shareDir, 0755); err != nil {
+		return "", fmt.Errorf("error creating data dir: %v", err)
+	}
+
+	path := fmt.Sprintf("%s/share-%s.txt", shareDir, m.date)
+	if err := os.WriteFile(path, []byte(card), 0644); err != nil {
+		return "", fmt.Errorf("error writing share card: %v", err)
+	}
+	return path, nil
+}
+
+// handleShare copies the share card of a finished game to the clipboard and saves it to a file.
+func (m *WordleModel) handleShare(spoilerFree bool) tea.Cmd {
+	if m.solvedIn() == 0 && m.cursorY < 6 {
+		m.message = "❌ Finish the puzzle before sharing."
+		return nil
+	}
+
+	card := m.shareCard(spoilerFree)
+	path, err := m.saveShareCard(card)
+	if err != nil {
+		m.message = err.Error()
+	} else {
+		m.message = "Result copied to clipboard and saved to " + path + "."
+	}
+
+	return tea.SetClipboard(strings.TrimSuffix(card, "\n"))
+}
@@ -0,0 +1,118 @@
+package wordle
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Stats summarises every finished game in the wordle table.
+type Stats struct {
+	Played        int
+	Won           int
+	CurrentStreak int
+	MaxStreak     int
+	HardWins      int
+
+	// Distribution counts the wins solved in each number of guesses
+	Distribution [6]int
+}
+
+// gameResult is the outcome of one finished game.
+type gameResult struct {
+	date time.Time
+
+	// guesses is the number of guesses a win took, or zero for a loss
+	guesses int
+	hard    bool
+}
+
+// WinPercent returns the share of played games that were won, rounded down.
+func (s Stats) WinPercent() int {
+	if s.Played == 0 {
+		return 0
+	}
+	return s.Won * 100 / s.Played
+}
+
+// LoadStats computes the statistics from the games saved in the database.
+func LoadStats() (Stats, error) {
+	db, err := getDB()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	rows, err := db.Query(`SELECT date, answer, guesses, cursor_y, hard_mode FROM wordle ORDER BY date`)
+	if err != nil {
+		return Stats{}, err
+	}
+	defer rows.Close()
+
+	var results []gameResult
+	for rows.Next() {
+		var date, answer string
+		var guessesJSON []byte
+		var cursorY int
+		var hard bool
+		if err := rows.Scan(&date, &answer, &guessesJSON, &cursorY, &hard); err != nil {
+			return Stats{}, err
+		}
+
+		day, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			continue
+		}
+
+		var guesses [6]string
+		json.Unmarshal(guessesJSON, &guesses)
+
+		// Games still in progress do not count
+		solvedIn, finished := gameOutcome(answer, guesses, cursorY)
+		if !finished {
+			continue
+		}
+
+		results = append(results, gameResult{date: day, guesses: solvedIn, hard: hard})
+	}
+	if err := rows.Err(); err != nil {
+		return Stats{}, err
+	}
+
+	return computeStats(results, time.Now()), nil
+}
+
+// computeStats totals results sorted by date. A streak counts wins on consecutive days and
+// the current streak only holds while the latest win was today or yesterday.
+func computeStats(results []gameResult, now time.Time) Stats {
+	var stats Stats
+	streak := 0
+	var previous time.Time
+
+	for _, result := range results {
+		stats.Played++
+		if result.guesses == 0 {
+			streak = 0
+			continue
+		}
+
+		stats.Won++
+		stats.Distribution[result.guesses-1]++
+		if result.hard {
+			stats.HardWins++
+		}
+
+		if streak > 0 && result.date.Sub(previous) == 24*time.Hour {
+			streak++
+		} else {
+			streak = 1
+		}
+		previous = result.date
+		stats.MaxStreak = max(stats.MaxStreak, streak)
+	}
+
+	today, _ := time.Parse("2006-01-02", now.Format("2006-01-02"))
+	if streak > 0 && today.Sub(previous) <= 24*time.Hour {
+		stats.CurrentStreak = streak
+	}
+
+	return stats
+}
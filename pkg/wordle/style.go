@@ -5,6 +5,9 @@ import (
 	"charm.land/lipgloss/v2"
 )
 
+// maxBarWidth is the length of the longest bar in the guess distribution.
+const maxBarWidth = 30
+
 var (
 	KeyCorrect = colors.Purple
 	KeyPresent = colors.Pink
@@ -16,6 +19,10 @@ var (
 	FGKeyAbsent  = lipgloss.NewStyle().Foreground(KeyAbsent)
 	FGText       = lipgloss.NewStyle().Foreground(Text)
 
+	StatTitle  = lipgloss.NewStyle().Foreground(KeyCorrect).Bold(true).Margin(1, 0)
+	StatValue  = lipgloss.NewStyle().Foreground(Text).Bold(true)
+	StatColumn = lipgloss.NewStyle().Width(10).Align(lipgloss.Center)
+
 	Border = lipgloss.NewStyle().
 		Padding(0, 1).
 		Border(lipgloss.NormalBorder())
@@ -1,6 +1,12 @@
 package wordle
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"charm.land/lipgloss/v2"
 
 	tea "charm.land/bubbletea/v2"
@@ -14,6 +20,13 @@ var (
 
 // View renders the entire game UI.
 func (m *WordleModel) View() tea.View {
+	if m.showStats {
+		return tea.NewView(FGText.Render(m.viewStats()))
+	}
+	if m.archive != nil {
+		return tea.NewView(FGText.Render(m.viewArchive()))
+	}
+
 	// Generate each row of the Wordle grid
 	var rows [6]string
 	for y := range m.guesses {
@@ -22,8 +35,16 @@ func (m *WordleModel) View() tea.View {
 
 	// Vertically join all rows with center alignment and compose the full view
 	joindedRows := lipgloss.JoinVertical(lipgloss.Center, rows[:]...)
+
+	// Show the puzzle date, and the mode while hard mode is on
+	mode := FGKeyAbsent.Render(m.date)
+	if m.hardMode {
+		mode += FGKeyAbsent.Render(" · ") + FGKeyPresent.Render("Hard mode")
+	}
+
 	output := lipgloss.JoinVertical(
 		lipgloss.Center,
+		mode,
 		joindedRows,
 		m.viewKeyboard(),
 		"\n"+m.message+"\n",
@@ -32,31 +53,109 @@ func (m *WordleModel) View() tea.View {
 	return tea.NewView(FGText.Render(output))
 }
 
-// viewGridRow renders a single row of the Wordle grid based on its position.
-func (m *WordleModel) viewGridRow(y int) string {
-	// Initialize keyStates to keyAbsent
-	keyStates := [5]int{1, 1, 1, 1, 1}
-	guess := m.guesses[y]
-	answer := m.answer
+// viewStats renders the statistics screen with the guess distribution histogram.
+func (m *WordleModel) viewStats() string {
+	stats := m.stats
+
+	// Headline numbers side by side, each over its label
+	figures := []struct {
+		value int
+		label string
+	}{
+		{stats.Played, "Played"},
+		{stats.WinPercent(), "Win %"},
+		{stats.CurrentStreak, "Current\nStreak"},
+		{stats.MaxStreak, "Max\nStreak"},
+	}
 
-	var cells [5]string
-	for i, letter := range guess {
-		// Mark letters after cursor as keyUntried
-		if y >= m.cursorY {
-			keyStates[i] = keyUntried
-			answer[i] = 0
+	columns := make([]string, len(figures))
+	for i, f := range figures {
+		columns[i] = StatColumn.Render(lipgloss.JoinVertical(
+			lipgloss.Center,
+			StatValue.Render(strconv.Itoa(f.value)),
+			f.label,
+		))
+	}
 
-			// If the letter matches the answer at this position mark as keyCorrect
-		} else if letter == answer[i] {
-			keyStates[i] = keyCorrect
-			answer[i] = 0
+	// One bar per guess count, scaled to the most common one
+	most := max(slices.Max(stats.Distribution[:]), 1)
+	solved := m.solvedIn()
+	bars := make([]string, len(stats.Distribution))
+	for i, count := range stats.Distribution {
+		style := FGKeyAbsent
+		if i+1 == solved {
+			style = FGKeyCorrect
+		}
 
-			// If the letter is found at a different position mark as keyPresent
-		} else if foundIdx := findIndex(answer, letter); foundIdx != -1 {
-			keyStates[i] = keyPresent
-			answer[foundIdx] = 0
+		width := count * maxBarWidth / most
+		bar := style.Render(strings.Repeat("█", width) + "▏")
+		bars[i] = fmt.Sprintf("%d %s %d", i+1, bar, count)
+	}
+
+	mode := "Hard mode off"
+	if m.hardMode {
+		mode = "Hard mode on"
+	}
+	mode = fmt.Sprintf("%s · %d won in hard mode", mode, stats.HardWins)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		StatTitle.Render("Statistics"),
+		lipgloss.JoinHorizontal(lipgloss.Top, columns...),
+		"\n"+FGKeyAbsent.Render(mode),
+		StatTitle.Render("Guess Distribution"),
+		lipgloss.JoinVertical(lipgloss.Left, bars...),
+		"\n"+m.message,
+		FGKeyAbsent.Render("Press tab to return"),
+	)
+}
+
+// viewArchive renders the date picker with the status of each puzzle.
+func (m *WordleModel) viewArchive() string {
+	a := m.archive
+	end := min(a.offset+archiveRows, len(a.dates))
+
+	rows := make([]string, 0, archiveRows)
+	for i := a.offset; i < end; i++ {
+		date := a.dates[i]
+		day, _ := time.Parse("2006-01-02", date)
+		status := a.status(date)
+
+		style := FGText
+		switch {
+		case strings.HasPrefix(status, "solved"):
+			style = FGKeyCorrect
+		case status == "in progress":
+			style = FGKeyPresent
+		case status == "failed":
+			style = FGKeyAbsent
+		}
+
+		prefix := "  "
+		if i == a.cursor {
+			prefix = FGKeyPresent.Render("> ")
 		}
+		rows = append(rows, prefix+fmt.Sprintf("%s  %s  ", date, day.Format("Mon"))+style.Render(status))
+	}
 
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		StatTitle.Render("Wordle Archive"),
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		"\n"+FGKeyAbsent.Render("↑/↓ move · pgup/pgdn page · enter to play · esc to close"),
+	)
+}
+
+// viewGridRow renders a single row of the Wordle grid based on its position.
+func (m *WordleModel) viewGridRow(y int) string {
+	// Rows that have not been submitted yet are left unstyled
+	var keyStates [5]int
+	if y < m.cursorY {
+		keyStates = scoreGuess(m.guesses[y], m.answer)
+	}
+
+	var cells [5]string
+	for i, letter := range m.guesses[y] {
 		// Style the cell
 		cellContent := string(rune(letter))
 		cellStyle := m.styleCell(keyStates[i])
@@ -105,6 +204,27 @@ func (m *WordleModel) styleCell(keyStyle int) lipgloss.Style {
 	}
 }
 
+// scoreGuess returns the state of each letter of a submitted guess.
+func scoreGuess(guess, answer [5]byte) [5]int {
+	// Initialize keyStates to keyAbsent
+	keyStates := [5]int{1, 1, 1, 1, 1}
+
+	for i, letter := range guess {
+		// If the letter matches the answer at this position mark as keyCorrect
+		if letter == answer[i] {
+			keyStates[i] = keyCorrect
+			answer[i] = 0
+
+			// If the letter is found at a different position mark as keyPresent
+		} else if foundIdx := findIndex(answer, letter); foundIdx != -1 {
+			keyStates[i] = keyPresent
+			answer[foundIdx] = 0
+		}
+	}
+
+	return keyStates
+}
+
 // findIndex searches for a character in a 5 letter word slice and returns its index or -1 if not found
 func findIndex(word [5]byte, char byte) int {
 	for i, c := range word {
@@ -5,6 +5,8 @@ import (
 	"time"
 	"unicode"
 
+	"ascii-arcade/pkg/registry"
+
 	tea "charm.land/bubbletea/v2"
 )
 
@@ -25,6 +27,29 @@ type WordleModel struct {
 	cursorY  int
 	keyboard map[byte]int
 	message  string
+	hardMode bool
+
+	showStats bool
+	stats     Stats
+
+	// archive is the date picker, while it is open
+	archive *archive
+}
+
+func init() {
+	registry.Register(registry.Game{
+		Name:     "Wordle",
+		Category: registry.NYT,
+		Order:    3,
+		New:      func() tea.Model { return InitWordleModel() },
+		Launchers: []registry.Launcher{
+			{
+				Flag:  "date",
+				Usage: "Play the Wordle puzzle for a date (YYYY-MM-DD)",
+				Open:  func(date string) (tea.Model, error) { return InitWordleModelForDate(date) },
+			},
+		},
+	})
 }
 
 // InitWordleModel creates and initializes a new wordle model.
@@ -48,7 +73,40 @@ func (m *WordleModel) Init() tea.Cmd {
 func (m *WordleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		// Any of the usual keys closes the statistics screen, which can also share the result
+		if m.showStats {
+			switch msg.String() {
+			case "tab", "esc", "enter":
+				m.showStats = false
+			case "ctrl+s":
+				return m, m.handleShare(true)
+			case "ctrl+g":
+				return m, m.handleShare(false)
+			}
+			return m, nil
+		}
+
+		if m.archive != nil {
+			m.handleArchiveKey(msg.String())
+			return m, nil
+		}
+
 		switch msg.String() {
+		case "tab":
+			m.handleShowStats()
+
+		case "ctrl+a":
+			m.handleOpenArchive()
+
+		case "ctrl+t":
+			m.handleToggleHardMode()
+
+		case "ctrl+s":
+			return m, m.handleShare(true)
+
+		case "ctrl+g":
+			return m, m.handleShare(false)
+
 		case "ctrl+r":
 			m.handleReset()
 
@@ -112,6 +170,14 @@ func (m *WordleModel) handleSubmit() {
 		return
 	}
 
+	// In hard mode every revealed hint must be used
+	if m.hardMode {
+		if reason := m.checkHardMode(m.guesses[m.cursorY]); reason != "" {
+			m.message = "❌ " + reason + "."
+			return
+		}
+	}
+
 	// Update keyboard state and move to the next row
 	m.updateKeyStates()
 	m.cursorY++
@@ -121,13 +187,107 @@ func (m *WordleModel) handleSubmit() {
 	if m.guesses[m.cursorY-1] == m.answer {
 		m.message = "🎉 Congratulations! You guessed the word! 🎉"
 		m.cursorY = 6
+		m.handleShowStats()
 		return
 	}
 
 	// If all guesses have been used, end the game
 	if m.cursorY == 6 {
 		m.message = fmt.Sprintf("❌ Game Over! The word was \"%s\" ❌", string(m.answer[:]))
+		m.handleShowStats()
+	}
+}
+
+// handleToggleHardMode switches hard mode on or off, which is only allowed before the first guess.
+func (m *WordleModel) handleToggleHardMode() {
+	if m.cursorY > 0 {
+		m.message = "❌ Hard mode can only be changed before the first guess."
+		return
+	}
+
+	m.hardMode = !m.hardMode
+	if m.hardMode {
+		m.message = "Hard mode on: revealed hints must be used in later guesses."
+	} else {
+		m.message = "Hard mode off."
+	}
+}
+
+// checkHardMode returns why a guess ignores a revealed hint, or an empty string if it uses them all.
+func (m *WordleModel) checkHardMode(guess [5]byte) string {
+	for y := range m.cursorY {
+		previous := m.guesses[y]
+		states := scoreGuess(previous, m.answer)
+
+		// Green letters must stay in their positions
+		for i, state := range states {
+			if state == keyCorrect && guess[i] != previous[i] {
+				return fmt.Sprintf("%s letter must be %c", ordinal(i+1), previous[i])
+			}
+		}
+
+		// Yellow letters must appear among the letters not already used by green ones
+		remaining := guess
+		for i, state := range states {
+			if state == keyCorrect {
+				remaining[i] = 0
+			}
+		}
+		for i, state := range states {
+			if state != keyPresent {
+				continue
+			}
+
+			idx := findIndex(remaining, previous[i])
+			if idx == -1 {
+				return fmt.Sprintf("Guess must contain %c", previous[i])
+			}
+			remaining[idx] = 0
+		}
+	}
+
+	return ""
+}
+
+// ordinal formats a letter position as 1st, 2nd, 3rd and so on.
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// handleShowStats saves the game so it is counted and opens the statistics screen.
+func (m *WordleModel) handleShowStats() {
+	if err := m.SaveToFile(); err != nil {
+		m.message = fmt.Sprintf("Failed to save wordle: %v", err)
+		return
+	}
+
+	stats, err := LoadStats()
+	if err != nil {
+		m.message = fmt.Sprintf("Failed to load statistics: %v", err)
+		return
+	}
+
+	m.stats = stats
+	m.showStats = true
+}
+
+// solvedIn returns the number of guesses the game was won in, or zero if it has not been won.
+func (m *WordleModel) solvedIn() int {
+	for i := range m.cursorY {
+		if m.guesses[i] == m.answer {
+			return i + 1
+		}
 	}
+	return 0
 }
 
 // handleInput processes a letter key input.